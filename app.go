@@ -34,6 +34,16 @@ func (a *App) GetTransactions(accountId int64, periodId int64, limit int, offset
 	return types.MapTransactionListResult(result)
 }
 
+func (a *App) SearchTransactions(input types.TransactionSearchInput) types.TransactionSearchResult {
+	result := a.s.SearchTransactions(input)
+	return types.MapTransactionSearchResult(result)
+}
+
+func (a *App) FullTextSearch(input types.FullTextSearchInput) types.TransactionMatchListResult {
+	result := a.s.FullTextSearch(input)
+	return types.MapTransactionMatchListResult(result)
+}
+
 func (a *App) GetAccounts() types.AccountListResult {
 	result := a.s.ListAccounts()
 	return types.MapAccountListResult(result)
@@ -69,11 +79,53 @@ func (a *App) UpdateTransaction(input types.TransactionUpdateInput) types.Transa
 	return types.MapTransactionResult(result)
 }
 
+func (a *App) SetTransactionStatus(input types.TransactionStatusInput) types.TransactionResult {
+	result := a.s.SetTransactionStatus(input)
+	return types.MapTransactionResult(result)
+}
+
+func (a *App) StartReconciliation(input types.ReconciliationInput) types.ReconciliationResult {
+	result := a.s.StartReconciliation(input)
+	return types.MapReconciliationResult(result)
+}
+
+func (a *App) GetOpenReconciliation(accountId int64) types.ReconciliationResult {
+	result := a.s.GetOpenReconciliation(accountId)
+	return types.MapReconciliationResult(result)
+}
+
+func (a *App) SetReconciliationCleared(reconciliationId int64, transactionId int64, cleared bool) types.ReconciliationResult {
+	result := a.s.SetReconciliationCleared(reconciliationId, transactionId, cleared)
+	return types.MapReconciliationResult(result)
+}
+
+func (a *App) FinalizeReconciliation(reconciliationId int64) types.ReconciliationResult {
+	result := a.s.FinalizeReconciliation(reconciliationId)
+	return types.MapReconciliationResult(result)
+}
+
+func (a *App) CancelReconciliation(reconciliationId int64) types.SimpleResult {
+	return a.s.CancelReconciliation(reconciliationId)
+}
+
+func (a *App) Transfer(input types.TransferInput) types.TransferResult {
+	result := a.s.Transfer(input)
+	return types.MapTransferResult(result)
+}
+
 func (a *App) ApplyRecurring(recurringId int64, periodId int64) types.TransactionResult {
 	result := a.s.ApplyRecurring(recurringId, periodId)
 	return types.MapTransactionResult(result)
 }
 
+func (a *App) ApplyRecurringWith(input types.ApplyRecurringInput) types.TransactionResult {
+	return types.MapTransactionResult(a.s.ApplyRecurringWith(input))
+}
+
+func (a *App) GetRecurringVariance(accountId int64, periodId int64) types.RecurringVarianceListResult {
+	return types.MapRecurringVarianceListResult(a.s.GetRecurringVariance(accountId, periodId))
+}
+
 func (a *App) AddAccount(name string, periodStartDay uint8) types.AccountResult {
 	result := a.s.AddAccount(name, periodStartDay)
 	return types.MapAccountResult(result)
@@ -84,6 +136,10 @@ func (a *App) AddRecurring(accountId int64, name string, amount int64, day uint8
 	return types.MapRecurringResult(result)
 }
 
+func (a *App) AddRecurringRule(accountId int64, input types.RecurringInput) types.RecurringResult {
+	return types.MapRecurringResult(a.s.AddRecurringRule(accountId, input))
+}
+
 func (a *App) DeleteRecurring(id int64) types.SimpleResult {
 	return a.s.DeleteRecurring(id)
 }
@@ -98,6 +154,16 @@ func (a *App) GetActivePeriod(accountId int64) types.PeriodResult {
 	return types.MapPeriodResult(result)
 }
 
+func (a *App) ListPeriods(accountId int64) types.PeriodSummaryListResult {
+	result := a.s.ListPeriods(accountId)
+	return types.MapPeriodSummaryListResult(result)
+}
+
+func (a *App) CloseActivePeriod(accountId int64) types.PeriodResult {
+	result := a.s.CloseActivePeriod(accountId)
+	return types.MapPeriodResult(result)
+}
+
 func (a *App) UpdateAccount(accountId int64, input types.AccountUpdateInput) types.AccountResult {
 	return types.MapAccountResult(a.s.UpdateAccount(accountId, input))
 }
@@ -110,6 +176,10 @@ func (a *App) ListCategories(accountId int64) types.CategoryListResult {
 	return types.MapCategoryListResult(a.s.ListCategories(accountId))
 }
 
+func (a *App) GetCategoryTotals(accountId int64, periodId int64) types.CategoryTotalListResult {
+	return types.MapCategoryTotalListResult(a.s.GetCategoryTotals(accountId, periodId))
+}
+
 func (a *App) AddCategory(accountId int64, input types.CategoryInsertInput) types.CategoryResult {
 	return types.MapCategoryResult(a.s.AddCategory(accountId, input))
 }
@@ -121,3 +191,103 @@ func (a *App) UpdateCategory(accountId int64, input types.CategoryUpdateInput) t
 func (a *App) DeleteCategory(categoryId int64) types.SimpleResult {
 	return a.s.DeleteCategory(categoryId)
 }
+
+func (a *App) SetBudget(input types.BudgetInput) types.BudgetResult {
+	return types.MapBudgetResult(a.s.SetBudget(input))
+}
+
+func (a *App) CopyPreviousBudgets(accountId int64, periodId int64) types.BudgetReportResult {
+	return types.MapBudgetReportResult(a.s.CopyPreviousBudgets(accountId, periodId))
+}
+
+func (a *App) GetBudgetReport(accountId int64, periodId int64) types.BudgetReportResult {
+	return types.MapBudgetReportResult(a.s.GetBudgetReport(accountId, periodId))
+}
+
+func (a *App) GetForecast(input types.ForecastInput) types.ForecastResult {
+	return types.MapForecastResult(a.s.GetForecast(input))
+}
+
+func (a *App) GetSpendingByCategory(input types.ReportRangeInput) types.SpendingReportResult {
+	return types.MapSpendingReportResult(a.s.GetSpendingByCategory(input))
+}
+
+func (a *App) GetIncomeExpense(input types.ReportRangeInput) types.IncomeExpenseReportResult {
+	return types.MapIncomeExpenseReportResult(a.s.GetIncomeExpense(input))
+}
+
+func (a *App) GetTrend(input types.TrendInput) types.TrendReportResult {
+	return types.MapTrendReportResult(a.s.GetTrend(input))
+}
+
+func (a *App) GetNetWorth() types.NetWorthResult {
+	return types.MapNetWorthResult(a.s.GetNetWorth())
+}
+
+func (a *App) GetBalanceSeries(accountId int64, periodId int64) types.BalanceSeriesResult {
+	return types.MapBalanceSeriesResult(a.s.GetBalanceSeries(accountId, periodId))
+}
+
+func (a *App) GetRolloverHistory(categoryId int64) types.BudgetRolloverListResult {
+	return types.MapBudgetRolloverListResult(a.s.GetRolloverHistory(categoryId))
+}
+
+func (a *App) ListImportProfiles(accountId int64) types.ImportProfileListResult {
+	return types.MapImportProfileListResult(a.s.ListImportProfiles(accountId))
+}
+
+func (a *App) AddImportProfile(accountId int64, input types.ImportProfileInput) types.ImportProfileResult {
+	return types.MapImportProfileResult(a.s.AddImportProfile(accountId, input))
+}
+
+func (a *App) UpdateImportProfile(accountId int64, input types.ImportProfileInput) types.ImportProfileResult {
+	return types.MapImportProfileResult(a.s.UpdateImportProfile(accountId, input))
+}
+
+func (a *App) DeleteImportProfile(profileId int64) types.SimpleResult {
+	return a.s.DeleteImportProfile(profileId)
+}
+
+func (a *App) PreviewCSVImport(input types.CSVImportInput) types.ImportRowListResult {
+	return types.MapImportRowListResult(a.s.PreviewCSVImport(input))
+}
+
+func (a *App) ImportCSV(input types.CSVImportInput) types.TransactionListResult {
+	return types.MapTransactionListResult(a.s.ImportCSV(input))
+}
+
+func (a *App) PreviewOFXImport(input types.FileImportInput) types.ImportRowListResult {
+	return types.MapImportRowListResult(a.s.PreviewOFXImport(input))
+}
+
+func (a *App) ImportOFX(input types.FileImportInput) types.TransactionListResult {
+	return types.MapTransactionListResult(a.s.ImportOFX(input))
+}
+
+func (a *App) PreviewQIFImport(input types.QIFImportInput) types.ImportRowListResult {
+	return types.MapImportRowListResult(a.s.PreviewQIFImport(input))
+}
+
+func (a *App) ImportQIF(input types.QIFImportInput) types.TransactionListResult {
+	return types.MapTransactionListResult(a.s.ImportQIF(input))
+}
+
+func (a *App) ExportQIF(input types.PeriodRangeInput) types.ExportFileResult {
+	return types.MapExportFileResult(a.s.ExportQIF(input))
+}
+
+func (a *App) ExportJournal(input types.JournalExportInput) types.ExportFileResult {
+	return types.MapExportFileResult(a.s.ExportJournal(input))
+}
+
+func (a *App) ListStartupMigrations() types.MigrationListResult {
+	return types.MapMigrationListResult(a.s.ListStartupMigrations())
+}
+
+func (a *App) ExportLedger() types.ExportFileResult {
+	return types.MapExportFileResult(a.s.ExportLedger())
+}
+
+func (a *App) ImportLedger(content string) types.SimpleResult {
+	return a.s.ImportLedger(content)
+}
@@ -6,38 +6,118 @@ export function AddAccount(arg1:string,arg2:number):Promise<types.AccountResult>
 
 export function AddCategory(arg1:number,arg2:types.CategoryInsertInput):Promise<types.CategoryResult>;
 
+export function AddImportProfile(arg1:number,arg2:types.ImportProfileInput):Promise<types.ImportProfileResult>;
+
 export function AddRecurring(arg1:number,arg2:string,arg3:number,arg4:number,arg5:number):Promise<types.RecurringResult>;
 
+export function AddRecurringRule(arg1:number,arg2:types.RecurringInput):Promise<types.RecurringResult>;
+
 export function AddTransaction(arg1:types.TransactionInsertInput):Promise<types.TransactionResult>;
 
 export function ApplyRecurring(arg1:number,arg2:number):Promise<types.TransactionResult>;
 
+export function ApplyRecurringWith(arg1:types.ApplyRecurringInput):Promise<types.TransactionResult>;
+
+export function CancelReconciliation(arg1:number):Promise<types.SimpleResult>;
+
+export function CloseActivePeriod(arg1:number):Promise<types.PeriodResult>;
+
+export function CopyPreviousBudgets(arg1:number,arg2:number):Promise<types.BudgetReportResult>;
+
 export function DeleteAccount(arg1:number):Promise<types.SimpleResult>;
 
 export function DeleteCategory(arg1:number):Promise<types.SimpleResult>;
 
+export function DeleteImportProfile(arg1:number):Promise<types.SimpleResult>;
+
 export function DeleteRecurring(arg1:number):Promise<types.SimpleResult>;
 
 export function DeleteTransaction(arg1:number):Promise<types.SimpleResult>;
 
+export function ExportJournal(arg1:types.JournalExportInput):Promise<types.ExportFileResult>;
+
+export function ExportLedger():Promise<types.ExportFileResult>;
+
+export function ExportQIF(arg1:types.PeriodRangeInput):Promise<types.ExportFileResult>;
+
+export function FinalizeReconciliation(arg1:number):Promise<types.ReconciliationResult>;
+
+export function FullTextSearch(arg1:types.FullTextSearchInput):Promise<types.TransactionMatchListResult>;
+
 export function GetAccount(arg1:number):Promise<types.AccountResult>;
 
 export function GetAccounts():Promise<types.AccountListResult>;
 
 export function GetActivePeriod(arg1:number):Promise<types.PeriodResult>;
 
+export function GetBalanceSeries(arg1:number,arg2:number):Promise<types.BalanceSeriesResult>;
+
+export function GetBudgetReport(arg1:number,arg2:number):Promise<types.BudgetReportResult>;
+
+export function GetCategoryTotals(arg1:number,arg2:number):Promise<types.CategoryTotalListResult>;
+
 export function GetDefaultAccount():Promise<types.AccountResult>;
 
+export function GetForecast(arg1:types.ForecastInput):Promise<types.ForecastResult>;
+
+export function GetIncomeExpense(arg1:types.ReportRangeInput):Promise<types.IncomeExpenseReportResult>;
+
+export function GetNetWorth():Promise<types.NetWorthResult>;
+
+export function GetOpenReconciliation(arg1:number):Promise<types.ReconciliationResult>;
+
 export function GetRecurringList(arg1:number,arg2:number):Promise<types.RecurringListResult>;
 
+export function GetRecurringVariance(arg1:number,arg2:number):Promise<types.RecurringVarianceListResult>;
+
+export function GetRolloverHistory(arg1:number):Promise<types.BudgetRolloverListResult>;
+
+export function GetSpendingByCategory(arg1:types.ReportRangeInput):Promise<types.SpendingReportResult>;
+
 export function GetTransactions(arg1:number,arg2:number,arg3:number,arg4:number):Promise<types.TransactionListResult>;
 
+export function GetTrend(arg1:types.TrendInput):Promise<types.TrendReportResult>;
+
+export function ImportCSV(arg1:types.CSVImportInput):Promise<types.TransactionListResult>;
+
+export function ImportLedger(arg1:string):Promise<types.SimpleResult>;
+
+export function ImportOFX(arg1:types.FileImportInput):Promise<types.TransactionListResult>;
+
+export function ImportQIF(arg1:types.QIFImportInput):Promise<types.TransactionListResult>;
+
 export function ListCategories(arg1:number):Promise<types.CategoryListResult>;
 
+export function ListImportProfiles(arg1:number):Promise<types.ImportProfileListResult>;
+
+export function ListPeriods(arg1:number):Promise<types.PeriodSummaryListResult>;
+
+export function ListStartupMigrations():Promise<types.MigrationListResult>;
+
+export function PreviewCSVImport(arg1:types.CSVImportInput):Promise<types.ImportRowListResult>;
+
+export function PreviewOFXImport(arg1:types.FileImportInput):Promise<types.ImportRowListResult>;
+
+export function PreviewQIFImport(arg1:types.QIFImportInput):Promise<types.ImportRowListResult>;
+
+export function SearchTransactions(arg1:types.TransactionSearchInput):Promise<types.TransactionSearchResult>;
+
+export function SetBudget(arg1:types.BudgetInput):Promise<types.BudgetResult>;
+
+export function SetReconciliationCleared(arg1:number,arg2:number,arg3:boolean):Promise<types.ReconciliationResult>;
+
+export function SetTransactionStatus(arg1:types.TransactionStatusInput):Promise<types.TransactionResult>;
+
+export function StartReconciliation(arg1:types.ReconciliationInput):Promise<types.ReconciliationResult>;
+
+export function Transfer(arg1:types.TransferInput):Promise<types.TransferResult>;
+
 export function UpdateAccount(arg1:number,arg2:types.AccountUpdateInput):Promise<types.AccountResult>;
 
 export function UpdateCategory(arg1:number,arg2:types.CategoryUpdateInput):Promise<types.CategoryResult>;
 
+export function UpdateImportProfile(arg1:number,arg2:types.ImportProfileInput):Promise<types.ImportProfileResult>;
+
 export function UpdateRecurring(arg1:types.RecurringInput):Promise<types.RecurringResult>;
 
 export function UpdateTransaction(arg1:types.TransactionUpdateInput):Promise<types.TransactionResult>;
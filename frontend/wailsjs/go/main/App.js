@@ -10,10 +10,18 @@ export function AddCategory(arg1, arg2) {
   return window['go']['main']['App']['AddCategory'](arg1, arg2);
 }
 
+export function AddImportProfile(arg1, arg2) {
+  return window['go']['main']['App']['AddImportProfile'](arg1, arg2);
+}
+
 export function AddRecurring(arg1, arg2, arg3, arg4, arg5) {
   return window['go']['main']['App']['AddRecurring'](arg1, arg2, arg3, arg4, arg5);
 }
 
+export function AddRecurringRule(arg1, arg2) {
+  return window['go']['main']['App']['AddRecurringRule'](arg1, arg2);
+}
+
 export function AddTransaction(arg1) {
   return window['go']['main']['App']['AddTransaction'](arg1);
 }
@@ -22,6 +30,22 @@ export function ApplyRecurring(arg1, arg2) {
   return window['go']['main']['App']['ApplyRecurring'](arg1, arg2);
 }
 
+export function ApplyRecurringWith(arg1) {
+  return window['go']['main']['App']['ApplyRecurringWith'](arg1);
+}
+
+export function CancelReconciliation(arg1) {
+  return window['go']['main']['App']['CancelReconciliation'](arg1);
+}
+
+export function CloseActivePeriod(arg1) {
+  return window['go']['main']['App']['CloseActivePeriod'](arg1);
+}
+
+export function CopyPreviousBudgets(arg1, arg2) {
+  return window['go']['main']['App']['CopyPreviousBudgets'](arg1, arg2);
+}
+
 export function DeleteAccount(arg1) {
   return window['go']['main']['App']['DeleteAccount'](arg1);
 }
@@ -30,6 +54,10 @@ export function DeleteCategory(arg1) {
   return window['go']['main']['App']['DeleteCategory'](arg1);
 }
 
+export function DeleteImportProfile(arg1) {
+  return window['go']['main']['App']['DeleteImportProfile'](arg1);
+}
+
 export function DeleteRecurring(arg1) {
   return window['go']['main']['App']['DeleteRecurring'](arg1);
 }
@@ -38,6 +66,26 @@ export function DeleteTransaction(arg1) {
   return window['go']['main']['App']['DeleteTransaction'](arg1);
 }
 
+export function ExportJournal(arg1) {
+  return window['go']['main']['App']['ExportJournal'](arg1);
+}
+
+export function ExportLedger() {
+  return window['go']['main']['App']['ExportLedger']();
+}
+
+export function ExportQIF(arg1) {
+  return window['go']['main']['App']['ExportQIF'](arg1);
+}
+
+export function FinalizeReconciliation(arg1) {
+  return window['go']['main']['App']['FinalizeReconciliation'](arg1);
+}
+
+export function FullTextSearch(arg1) {
+  return window['go']['main']['App']['FullTextSearch'](arg1);
+}
+
 export function GetAccount(arg1) {
   return window['go']['main']['App']['GetAccount'](arg1);
 }
@@ -50,22 +98,130 @@ export function GetActivePeriod(arg1) {
   return window['go']['main']['App']['GetActivePeriod'](arg1);
 }
 
+export function GetBalanceSeries(arg1, arg2) {
+  return window['go']['main']['App']['GetBalanceSeries'](arg1, arg2);
+}
+
+export function GetBudgetReport(arg1, arg2) {
+  return window['go']['main']['App']['GetBudgetReport'](arg1, arg2);
+}
+
+export function GetCategoryTotals(arg1, arg2) {
+  return window['go']['main']['App']['GetCategoryTotals'](arg1, arg2);
+}
+
 export function GetDefaultAccount() {
   return window['go']['main']['App']['GetDefaultAccount']();
 }
 
+export function GetForecast(arg1) {
+  return window['go']['main']['App']['GetForecast'](arg1);
+}
+
+export function GetIncomeExpense(arg1) {
+  return window['go']['main']['App']['GetIncomeExpense'](arg1);
+}
+
+export function GetNetWorth() {
+  return window['go']['main']['App']['GetNetWorth']();
+}
+
+export function GetOpenReconciliation(arg1) {
+  return window['go']['main']['App']['GetOpenReconciliation'](arg1);
+}
+
 export function GetRecurringList(arg1, arg2) {
   return window['go']['main']['App']['GetRecurringList'](arg1, arg2);
 }
 
+export function GetRecurringVariance(arg1, arg2) {
+  return window['go']['main']['App']['GetRecurringVariance'](arg1, arg2);
+}
+
+export function GetRolloverHistory(arg1) {
+  return window['go']['main']['App']['GetRolloverHistory'](arg1);
+}
+
+export function GetSpendingByCategory(arg1) {
+  return window['go']['main']['App']['GetSpendingByCategory'](arg1);
+}
+
 export function GetTransactions(arg1, arg2, arg3, arg4) {
   return window['go']['main']['App']['GetTransactions'](arg1, arg2, arg3, arg4);
 }
 
+export function GetTrend(arg1) {
+  return window['go']['main']['App']['GetTrend'](arg1);
+}
+
+export function ImportCSV(arg1) {
+  return window['go']['main']['App']['ImportCSV'](arg1);
+}
+
+export function ImportLedger(arg1) {
+  return window['go']['main']['App']['ImportLedger'](arg1);
+}
+
+export function ImportOFX(arg1) {
+  return window['go']['main']['App']['ImportOFX'](arg1);
+}
+
+export function ImportQIF(arg1) {
+  return window['go']['main']['App']['ImportQIF'](arg1);
+}
+
 export function ListCategories(arg1) {
   return window['go']['main']['App']['ListCategories'](arg1);
 }
 
+export function ListImportProfiles(arg1) {
+  return window['go']['main']['App']['ListImportProfiles'](arg1);
+}
+
+export function ListPeriods(arg1) {
+  return window['go']['main']['App']['ListPeriods'](arg1);
+}
+
+export function ListStartupMigrations() {
+  return window['go']['main']['App']['ListStartupMigrations']();
+}
+
+export function PreviewCSVImport(arg1) {
+  return window['go']['main']['App']['PreviewCSVImport'](arg1);
+}
+
+export function PreviewOFXImport(arg1) {
+  return window['go']['main']['App']['PreviewOFXImport'](arg1);
+}
+
+export function PreviewQIFImport(arg1) {
+  return window['go']['main']['App']['PreviewQIFImport'](arg1);
+}
+
+export function SearchTransactions(arg1) {
+  return window['go']['main']['App']['SearchTransactions'](arg1);
+}
+
+export function SetBudget(arg1) {
+  return window['go']['main']['App']['SetBudget'](arg1);
+}
+
+export function SetReconciliationCleared(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SetReconciliationCleared'](arg1, arg2, arg3);
+}
+
+export function SetTransactionStatus(arg1) {
+  return window['go']['main']['App']['SetTransactionStatus'](arg1);
+}
+
+export function StartReconciliation(arg1) {
+  return window['go']['main']['App']['StartReconciliation'](arg1);
+}
+
+export function Transfer(arg1) {
+  return window['go']['main']['App']['Transfer'](arg1);
+}
+
 export function UpdateAccount(arg1, arg2) {
   return window['go']['main']['App']['UpdateAccount'](arg1, arg2);
 }
@@ -74,6 +230,10 @@ export function UpdateCategory(arg1, arg2) {
   return window['go']['main']['App']['UpdateCategory'](arg1, arg2);
 }
 
+export function UpdateImportProfile(arg1, arg2) {
+  return window['go']['main']['App']['UpdateImportProfile'](arg1, arg2);
+}
+
 export function UpdateRecurring(arg1) {
   return window['go']['main']['App']['UpdateRecurring'](arg1);
 }
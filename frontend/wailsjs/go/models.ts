@@ -1,11 +1,87 @@
 export namespace types {
 	
+	export class TransactionSplit {
+	    id: number;
+	    category_id: number;
+	    amount: number;
+	    memo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TransactionSplit(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.category_id = source["category_id"];
+	        this.amount = source["amount"];
+	        this.memo = source["memo"];
+	    }
+	}
+	export class Transaction {
+	    id: number;
+	    account_id: number;
+	    period_id: number;
+	    category_id: number;
+	    date: number;
+	    display_date: string;
+	    amount: number;
+	    name: string;
+	    notes: string;
+	    from_recurring_id: number;
+	    transfer_id: number;
+	    status: string;
+	    splits: TransactionSplit[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Transaction(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.account_id = source["account_id"];
+	        this.period_id = source["period_id"];
+	        this.category_id = source["category_id"];
+	        this.date = source["date"];
+	        this.display_date = source["display_date"];
+	        this.amount = source["amount"];
+	        this.name = source["name"];
+	        this.notes = source["notes"];
+	        this.from_recurring_id = source["from_recurring_id"];
+	        this.transfer_id = source["transfer_id"];
+	        this.status = source["status"];
+	        this.splits = this.convertValues(source["splits"], TransactionSplit);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class Period {
 	    id: number;
 	    reporting_start: string;
 	    reporting_end: string;
 	    opened_on: string;
 	    balance: number;
+	    cleared_balance: number;
+	    working_balance: number;
+	    auto_applied: Transaction[];
+	    auto_applied_total: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new Period(source);
@@ -18,7 +94,29 @@ export namespace types {
 	        this.reporting_end = source["reporting_end"];
 	        this.opened_on = source["opened_on"];
 	        this.balance = source["balance"];
+	        this.cleared_balance = source["cleared_balance"];
+	        this.working_balance = source["working_balance"];
+	        this.auto_applied = this.convertValues(source["auto_applied"], Transaction);
+	        this.auto_applied_total = source["auto_applied_total"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
 	export class Account {
 	    id: number;
@@ -35,9 +133,2115 @@ export namespace types {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
 	        this.name = source["name"];
-	        this.period_start_day = source["period_start_day"];
-	        this.can_delete = source["can_delete"];
-	        this.active_period = this.convertValues(source["active_period"], Period);
+	        this.period_start_day = source["period_start_day"];
+	        this.can_delete = source["can_delete"];
+	        this.active_period = this.convertValues(source["active_period"], Period);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class AccountBalance {
+	    account_id: number;
+	    name: string;
+	    balance: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new AccountBalance(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.name = source["name"];
+	        this.balance = source["balance"];
+	    }
+	}
+	export class AccountListResult {
+	    success: boolean;
+	    message: string;
+	    data: Account[];
+	
+	    static createFrom(source: any = {}) {
+	        return new AccountListResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], Account);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class AccountResult {
+	    success: boolean;
+	    message: string;
+	    data: Account;
+	
+	    static createFrom(source: any = {}) {
+	        return new AccountResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], Account);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class AccountUpdateInput {
+	    name: string;
+	    period_start_day: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new AccountUpdateInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.period_start_day = source["period_start_day"];
+	    }
+	}
+	export class ApplyRecurringInput {
+	    recurring_id: number;
+	    period_id: number;
+	    amount?: number;
+	    date: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ApplyRecurringInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.recurring_id = source["recurring_id"];
+	        this.period_id = source["period_id"];
+	        this.amount = source["amount"];
+	        this.date = source["date"];
+	    }
+	}
+	export class BalanceEntry {
+	    transaction: Transaction;
+	    balance: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new BalanceEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.transaction = this.convertValues(source["transaction"], Transaction);
+	        this.balance = source["balance"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DailyBalance {
+	    date: number;
+	    display_date: string;
+	    balance: number;
+	    change: number;
+	    count: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DailyBalance(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.date = source["date"];
+	        this.display_date = source["display_date"];
+	        this.balance = source["balance"];
+	        this.change = source["change"];
+	        this.count = source["count"];
+	    }
+	}
+	export class BalanceSeries {
+	    account_id: number;
+	    period: Period;
+	    opening_balance: number;
+	    entries: BalanceEntry[];
+	    days: DailyBalance[];
+	
+	    static createFrom(source: any = {}) {
+	        return new BalanceSeries(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.period = this.convertValues(source["period"], Period);
+	        this.opening_balance = source["opening_balance"];
+	        this.entries = this.convertValues(source["entries"], BalanceEntry);
+	        this.days = this.convertValues(source["days"], DailyBalance);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class BalanceSeriesResult {
+	    success: boolean;
+	    message: string;
+	    data: BalanceSeries;
+	
+	    static createFrom(source: any = {}) {
+	        return new BalanceSeriesResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], BalanceSeries);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Budget {
+	    id: number;
+	    period_id: number;
+	    category_id: number;
+	    amount: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Budget(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.period_id = source["period_id"];
+	        this.category_id = source["category_id"];
+	        this.amount = source["amount"];
+	    }
+	}
+	export class BudgetInput {
+	    account_id: number;
+	    period_id: number;
+	    category_id: number;
+	    amount: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new BudgetInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.period_id = source["period_id"];
+	        this.category_id = source["category_id"];
+	        this.amount = source["amount"];
+	    }
+	}
+	export class BudgetLine {
+	    category_id: number;
+	    name: string;
+	    color: string;
+	    rollover: boolean;
+	    budgeted: number;
+	    carried_in: number;
+	    available: number;
+	    spent: number;
+	    remaining: number;
+	    percent: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new BudgetLine(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.category_id = source["category_id"];
+	        this.name = source["name"];
+	        this.color = source["color"];
+	        this.rollover = source["rollover"];
+	        this.budgeted = source["budgeted"];
+	        this.carried_in = source["carried_in"];
+	        this.available = source["available"];
+	        this.spent = source["spent"];
+	        this.remaining = source["remaining"];
+	        this.percent = source["percent"];
+	    }
+	}
+	export class BudgetReport {
+	    period: Period;
+	    lines: BudgetLine[];
+	    total_budgeted: number;
+	    total_carried_in: number;
+	    total_available: number;
+	    total_spent: number;
+	    total_remaining: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new BudgetReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.period = this.convertValues(source["period"], Period);
+	        this.lines = this.convertValues(source["lines"], BudgetLine);
+	        this.total_budgeted = source["total_budgeted"];
+	        this.total_carried_in = source["total_carried_in"];
+	        this.total_available = source["total_available"];
+	        this.total_spent = source["total_spent"];
+	        this.total_remaining = source["total_remaining"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class BudgetReportResult {
+	    success: boolean;
+	    message: string;
+	    data: BudgetReport;
+	
+	    static createFrom(source: any = {}) {
+	        return new BudgetReportResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], BudgetReport);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class BudgetResult {
+	    success: boolean;
+	    message: string;
+	    data: Budget;
+	
+	    static createFrom(source: any = {}) {
+	        return new BudgetResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], Budget);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class BudgetRollover {
+	    id: number;
+	    category_id: number;
+	    from_period_id: number;
+	    to_period_id: number;
+	    period_start: string;
+	    amount: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new BudgetRollover(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.category_id = source["category_id"];
+	        this.from_period_id = source["from_period_id"];
+	        this.to_period_id = source["to_period_id"];
+	        this.period_start = source["period_start"];
+	        this.amount = source["amount"];
+	    }
+	}
+	export class BudgetRolloverListResult {
+	    success: boolean;
+	    message: string;
+	    data: BudgetRollover[];
+	
+	    static createFrom(source: any = {}) {
+	        return new BudgetRolloverListResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], BudgetRollover);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CSVImportInput {
+	    account_id: number;
+	    profile_id: number;
+	    category_id: number;
+	    file_name: string;
+	    content: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CSVImportInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.profile_id = source["profile_id"];
+	        this.category_id = source["category_id"];
+	        this.file_name = source["file_name"];
+	        this.content = source["content"];
+	    }
+	}
+	export class Category {
+	    id: number;
+	    name: string;
+	    color: string;
+	    rollover: boolean;
+	    kind: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Category(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.color = source["color"];
+	        this.rollover = source["rollover"];
+	        this.kind = source["kind"];
+	    }
+	}
+	export class CategoryInsertInput {
+	    name: string;
+	    color: string;
+	    rollover?: boolean;
+	    kind: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CategoryInsertInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.color = source["color"];
+	        this.rollover = source["rollover"];
+	        this.kind = source["kind"];
+	    }
+	}
+	export class CategoryListResult {
+	    success: boolean;
+	    message: string;
+	    data: Category[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CategoryListResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], Category);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CategoryResult {
+	    success: boolean;
+	    message: string;
+	    data: Category;
+	
+	    static createFrom(source: any = {}) {
+	        return new CategoryResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], Category);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CategorySpending {
+	    category_id: number;
+	    name: string;
+	    color: string;
+	    inflow: number;
+	    outflow: number;
+	    net: number;
+	    count: number;
+	    share: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CategorySpending(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.category_id = source["category_id"];
+	        this.name = source["name"];
+	        this.color = source["color"];
+	        this.inflow = source["inflow"];
+	        this.outflow = source["outflow"];
+	        this.net = source["net"];
+	        this.count = source["count"];
+	        this.share = source["share"];
+	    }
+	}
+	export class CategoryTotal {
+	    category_id: number;
+	    name: string;
+	    color: string;
+	    amount: number;
+	    count: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CategoryTotal(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.category_id = source["category_id"];
+	        this.name = source["name"];
+	        this.color = source["color"];
+	        this.amount = source["amount"];
+	        this.count = source["count"];
+	    }
+	}
+	export class CategoryTotalListResult {
+	    success: boolean;
+	    message: string;
+	    data: CategoryTotal[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CategoryTotalListResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], CategoryTotal);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CategoryTrend {
+	    category_id: number;
+	    name: string;
+	    color: string;
+	    spent: number[];
+	    deltas: number[];
+	    total: number;
+	    average: number;
+	    min: number;
+	    max: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CategoryTrend(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.category_id = source["category_id"];
+	        this.name = source["name"];
+	        this.color = source["color"];
+	        this.spent = source["spent"];
+	        this.deltas = source["deltas"];
+	        this.total = source["total"];
+	        this.average = source["average"];
+	        this.min = source["min"];
+	        this.max = source["max"];
+	    }
+	}
+	export class CategoryUpdateInput {
+	    id: number;
+	    name: string;
+	    color: string;
+	    rollover?: boolean;
+	    kind: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CategoryUpdateInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.color = source["color"];
+	        this.rollover = source["rollover"];
+	        this.kind = source["kind"];
+	    }
+	}
+	
+	export class ExportFile {
+	    file_name: string;
+	    content: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ExportFile(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.file_name = source["file_name"];
+	        this.content = source["content"];
+	    }
+	}
+	export class ExportFileResult {
+	    success: boolean;
+	    message: string;
+	    data: ExportFile;
+	
+	    static createFrom(source: any = {}) {
+	        return new ExportFileResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], ExportFile);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class FileImportInput {
+	    account_id: number;
+	    category_id: number;
+	    file_name: string;
+	    content: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new FileImportInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.category_id = source["category_id"];
+	        this.file_name = source["file_name"];
+	        this.content = source["content"];
+	    }
+	}
+	export class ForecastEvent {
+	    recurring_id: number;
+	    name: string;
+	    amount: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ForecastEvent(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.recurring_id = source["recurring_id"];
+	        this.name = source["name"];
+	        this.amount = source["amount"];
+	    }
+	}
+	export class ForecastDay {
+	    date: number;
+	    display_date: string;
+	    balance: number;
+	    change: number;
+	    events: ForecastEvent[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ForecastDay(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.date = source["date"];
+	        this.display_date = source["display_date"];
+	        this.balance = source["balance"];
+	        this.change = source["change"];
+	        this.events = this.convertValues(source["events"], ForecastEvent);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Forecast {
+	    account_id: number;
+	    start_balance: number;
+	    end_balance: number;
+	    lowest_balance: number;
+	    lowest_date: number;
+	    display_lowest_date: string;
+	    days: ForecastDay[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Forecast(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.start_balance = source["start_balance"];
+	        this.end_balance = source["end_balance"];
+	        this.lowest_balance = source["lowest_balance"];
+	        this.lowest_date = source["lowest_date"];
+	        this.display_lowest_date = source["display_lowest_date"];
+	        this.days = this.convertValues(source["days"], ForecastDay);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class ForecastInput {
+	    account_id: number;
+	    days: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ForecastInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.days = source["days"];
+	    }
+	}
+	export class ForecastResult {
+	    success: boolean;
+	    message: string;
+	    data: Forecast;
+	
+	    static createFrom(source: any = {}) {
+	        return new ForecastResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], Forecast);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class FullTextSearchInput {
+	    account_id: number;
+	    query: string;
+	    limit: number;
+	    offset: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new FullTextSearchInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.query = source["query"];
+	        this.limit = source["limit"];
+	        this.offset = source["offset"];
+	    }
+	}
+	export class ImportProfile {
+	    id: number;
+	    name: string;
+	    delimiter: string;
+	    date_format: string;
+	    header_rows: number;
+	    date_column: number;
+	    name_column: number;
+	    amount_column: number;
+	    debit_column: number;
+	    credit_column: number;
+	    negate_amounts: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportProfile(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.delimiter = source["delimiter"];
+	        this.date_format = source["date_format"];
+	        this.header_rows = source["header_rows"];
+	        this.date_column = source["date_column"];
+	        this.name_column = source["name_column"];
+	        this.amount_column = source["amount_column"];
+	        this.debit_column = source["debit_column"];
+	        this.credit_column = source["credit_column"];
+	        this.negate_amounts = source["negate_amounts"];
+	    }
+	}
+	export class ImportProfileInput {
+	    id: number;
+	    name: string;
+	    delimiter: string;
+	    date_format: string;
+	    header_rows: number;
+	    date_column: number;
+	    name_column: number;
+	    amount_column: number;
+	    debit_column: number;
+	    credit_column: number;
+	    negate_amounts: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportProfileInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.delimiter = source["delimiter"];
+	        this.date_format = source["date_format"];
+	        this.header_rows = source["header_rows"];
+	        this.date_column = source["date_column"];
+	        this.name_column = source["name_column"];
+	        this.amount_column = source["amount_column"];
+	        this.debit_column = source["debit_column"];
+	        this.credit_column = source["credit_column"];
+	        this.negate_amounts = source["negate_amounts"];
+	    }
+	}
+	export class ImportProfileListResult {
+	    success: boolean;
+	    message: string;
+	    data: ImportProfile[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportProfileListResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], ImportProfile);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ImportProfileResult {
+	    success: boolean;
+	    message: string;
+	    data: ImportProfile;
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportProfileResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], ImportProfile);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ImportSplit {
+	    category: string;
+	    amount: number;
+	    memo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportSplit(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.category = source["category"];
+	        this.amount = source["amount"];
+	        this.memo = source["memo"];
+	    }
+	}
+	export class ImportRow {
+	    line: number;
+	    period_id: number;
+	    date: number;
+	    display_date: string;
+	    amount: number;
+	    name: string;
+	    notes: string;
+	    category_id: number;
+	    category: string;
+	    external_id: string;
+	    splits: ImportSplit[];
+	    opening_balance: boolean;
+	    duplicate: boolean;
+	    error: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportRow(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.line = source["line"];
+	        this.period_id = source["period_id"];
+	        this.date = source["date"];
+	        this.display_date = source["display_date"];
+	        this.amount = source["amount"];
+	        this.name = source["name"];
+	        this.notes = source["notes"];
+	        this.category_id = source["category_id"];
+	        this.category = source["category"];
+	        this.external_id = source["external_id"];
+	        this.splits = this.convertValues(source["splits"], ImportSplit);
+	        this.opening_balance = source["opening_balance"];
+	        this.duplicate = source["duplicate"];
+	        this.error = source["error"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ImportRowListResult {
+	    success: boolean;
+	    message: string;
+	    data: ImportRow[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportRowListResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], ImportRow);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class IncomeExpense {
+	    period_id: number;
+	    reporting_start: string;
+	    reporting_end: string;
+	    year: number;
+	    income: number;
+	    expense: number;
+	    savings: number;
+	    savings_rate: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new IncomeExpense(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.period_id = source["period_id"];
+	        this.reporting_start = source["reporting_start"];
+	        this.reporting_end = source["reporting_end"];
+	        this.year = source["year"];
+	        this.income = source["income"];
+	        this.expense = source["expense"];
+	        this.savings = source["savings"];
+	        this.savings_rate = source["savings_rate"];
+	    }
+	}
+	export class IncomeExpenseReport {
+	    account_id: number;
+	    period_id: number;
+	    from: string;
+	    to: string;
+	    periods: IncomeExpense[];
+	    years: IncomeExpense[];
+	    total: IncomeExpense;
+	
+	    static createFrom(source: any = {}) {
+	        return new IncomeExpenseReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.period_id = source["period_id"];
+	        this.from = source["from"];
+	        this.to = source["to"];
+	        this.periods = this.convertValues(source["periods"], IncomeExpense);
+	        this.years = this.convertValues(source["years"], IncomeExpense);
+	        this.total = this.convertValues(source["total"], IncomeExpense);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class IncomeExpenseReportResult {
+	    success: boolean;
+	    message: string;
+	    data: IncomeExpenseReport;
+	
+	    static createFrom(source: any = {}) {
+	        return new IncomeExpenseReportResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], IncomeExpenseReport);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class JournalExportInput {
+	    account_id: number;
+	    start_date: number;
+	    end_date: number;
+	    format: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new JournalExportInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.start_date = source["start_date"];
+	        this.end_date = source["end_date"];
+	        this.format = source["format"];
+	    }
+	}
+	export class Migration {
+	    version: number;
+	    name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Migration(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.version = source["version"];
+	        this.name = source["name"];
+	    }
+	}
+	export class MigrationListResult {
+	    success: boolean;
+	    message: string;
+	    data: Migration[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MigrationListResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], Migration);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NetWorthPoint {
+	    date: number;
+	    display_date: string;
+	    total: number;
+	    accounts: AccountBalance[];
+	
+	    static createFrom(source: any = {}) {
+	        return new NetWorthPoint(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.date = source["date"];
+	        this.display_date = source["display_date"];
+	        this.total = source["total"];
+	        this.accounts = this.convertValues(source["accounts"], AccountBalance);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NetWorth {
+	    by_period: boolean;
+	    points: NetWorthPoint[];
+	
+	    static createFrom(source: any = {}) {
+	        return new NetWorth(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.by_period = source["by_period"];
+	        this.points = this.convertValues(source["points"], NetWorthPoint);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class NetWorthResult {
+	    success: boolean;
+	    message: string;
+	    data: NetWorth;
+	
+	    static createFrom(source: any = {}) {
+	        return new NetWorthResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], NetWorth);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class PeriodRangeInput {
+	    account_id: number;
+	    from_period_id: number;
+	    to_period_id: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PeriodRangeInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.from_period_id = source["from_period_id"];
+	        this.to_period_id = source["to_period_id"];
+	    }
+	}
+	export class PeriodResult {
+	    success: boolean;
+	    message: string;
+	    data: Period;
+	
+	    static createFrom(source: any = {}) {
+	        return new PeriodResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], Period);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PeriodSummary {
+	    id: number;
+	    reporting_start: string;
+	    reporting_end: string;
+	    opened_on: string;
+	    closed_on: string;
+	    is_closed: boolean;
+	    opening_balance: number;
+	    total_inflow: number;
+	    total_outflow: number;
+	    ending_balance: number;
+	    cleared_balance: number;
+	    working_balance: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PeriodSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.reporting_start = source["reporting_start"];
+	        this.reporting_end = source["reporting_end"];
+	        this.opened_on = source["opened_on"];
+	        this.closed_on = source["closed_on"];
+	        this.is_closed = source["is_closed"];
+	        this.opening_balance = source["opening_balance"];
+	        this.total_inflow = source["total_inflow"];
+	        this.total_outflow = source["total_outflow"];
+	        this.ending_balance = source["ending_balance"];
+	        this.cleared_balance = source["cleared_balance"];
+	        this.working_balance = source["working_balance"];
+	    }
+	}
+	export class PeriodSummaryListResult {
+	    success: boolean;
+	    message: string;
+	    data: PeriodSummary[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PeriodSummaryListResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], PeriodSummary);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class QIFImportInput {
+	    account_id: number;
+	    category_id: number;
+	    file_name: string;
+	    date_format: string;
+	    content: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new QIFImportInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.category_id = source["category_id"];
+	        this.file_name = source["file_name"];
+	        this.date_format = source["date_format"];
+	        this.content = source["content"];
+	    }
+	}
+	export class Reconciliation {
+	    id: number;
+	    account_id: number;
+	    statement_date: number;
+	    display_statement_date: string;
+	    statement_balance: number;
+	    cleared_balance: number;
+	    difference: number;
+	    is_finalized: boolean;
+	    transactions: Transaction[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Reconciliation(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.account_id = source["account_id"];
+	        this.statement_date = source["statement_date"];
+	        this.display_statement_date = source["display_statement_date"];
+	        this.statement_balance = source["statement_balance"];
+	        this.cleared_balance = source["cleared_balance"];
+	        this.difference = source["difference"];
+	        this.is_finalized = source["is_finalized"];
+	        this.transactions = this.convertValues(source["transactions"], Transaction);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ReconciliationInput {
+	    account_id: number;
+	    statement_date: number;
+	    statement_balance: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ReconciliationInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.statement_date = source["statement_date"];
+	        this.statement_balance = source["statement_balance"];
+	    }
+	}
+	export class ReconciliationResult {
+	    success: boolean;
+	    message: string;
+	    data: Reconciliation;
+	
+	    static createFrom(source: any = {}) {
+	        return new ReconciliationResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], Reconciliation);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RecurringOccurrence {
+	    date: number;
+	    display_date: string;
+	    actualized: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new RecurringOccurrence(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.date = source["date"];
+	        this.display_date = source["display_date"];
+	        this.actualized = source["actualized"];
+	    }
+	}
+	export class Recurring {
+	    id: number;
+	    name: string;
+	    amount: number;
+	    category_id: number;
+	    day: number;
+	    frequency: string;
+	    interval: number;
+	    anchor_date: number;
+	    end_date: number;
+	    count: number;
+	    weekday: number;
+	    auto_apply: boolean;
+	    accounted_for: boolean;
+	    occurrences: RecurringOccurrence[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Recurring(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.amount = source["amount"];
+	        this.category_id = source["category_id"];
+	        this.day = source["day"];
+	        this.frequency = source["frequency"];
+	        this.interval = source["interval"];
+	        this.anchor_date = source["anchor_date"];
+	        this.end_date = source["end_date"];
+	        this.count = source["count"];
+	        this.weekday = source["weekday"];
+	        this.auto_apply = source["auto_apply"];
+	        this.accounted_for = source["accounted_for"];
+	        this.occurrences = this.convertValues(source["occurrences"], RecurringOccurrence);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RecurringInput {
+	    id: number;
+	    amount: number;
+	    category_id: number;
+	    name: string;
+	    day: number;
+	    frequency: string;
+	    interval: number;
+	    anchor_date: number;
+	    end_date?: number;
+	    count?: number;
+	    weekday?: number;
+	    auto_apply?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new RecurringInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.amount = source["amount"];
+	        this.category_id = source["category_id"];
+	        this.name = source["name"];
+	        this.day = source["day"];
+	        this.frequency = source["frequency"];
+	        this.interval = source["interval"];
+	        this.anchor_date = source["anchor_date"];
+	        this.end_date = source["end_date"];
+	        this.count = source["count"];
+	        this.weekday = source["weekday"];
+	        this.auto_apply = source["auto_apply"];
+	    }
+	}
+	export class RecurringListResult {
+	    success: boolean;
+	    message: string;
+	    data: Recurring[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RecurringListResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], Recurring);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class RecurringResult {
+	    success: boolean;
+	    message: string;
+	    data: Recurring;
+	
+	    static createFrom(source: any = {}) {
+	        return new RecurringResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], Recurring);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RecurringVariance {
+	    id: number;
+	    recurring_id: number;
+	    name: string;
+	    occurrence_date: string;
+	    expected: number;
+	    actual: number;
+	    variance: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RecurringVariance(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.recurring_id = source["recurring_id"];
+	        this.name = source["name"];
+	        this.occurrence_date = source["occurrence_date"];
+	        this.expected = source["expected"];
+	        this.actual = source["actual"];
+	        this.variance = source["variance"];
+	    }
+	}
+	export class RecurringVarianceListResult {
+	    success: boolean;
+	    message: string;
+	    data: RecurringVariance[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RecurringVarianceListResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], RecurringVariance);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ReportRangeInput {
+	    account_id: number;
+	    period_id: number;
+	    from: number;
+	    to: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ReportRangeInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.period_id = source["period_id"];
+	        this.from = source["from"];
+	        this.to = source["to"];
+	    }
+	}
+	export class SimpleResult {
+	    success: boolean;
+	    message: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SimpleResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	    }
+	}
+	export class SpendingReport {
+	    account_id: number;
+	    period_id: number;
+	    from: string;
+	    to: string;
+	    categories: CategorySpending[];
+	    total_inflow: number;
+	    total_outflow: number;
+	    net: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SpendingReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.period_id = source["period_id"];
+	        this.from = source["from"];
+	        this.to = source["to"];
+	        this.categories = this.convertValues(source["categories"], CategorySpending);
+	        this.total_inflow = source["total_inflow"];
+	        this.total_outflow = source["total_outflow"];
+	        this.net = source["net"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SpendingReportResult {
+	    success: boolean;
+	    message: string;
+	    data: SpendingReport;
+	
+	    static createFrom(source: any = {}) {
+	        return new SpendingReportResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], SpendingReport);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class TransactionSplitInput {
+	    category_id: number;
+	    amount: number;
+	    memo: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TransactionSplitInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.category_id = source["category_id"];
+	        this.amount = source["amount"];
+	        this.memo = source["memo"];
+	    }
+	}
+	export class TransactionInsertInput {
+	    account_id: number;
+	    period_id: number;
+	    category_id: number;
+	    date: number;
+	    amount: number;
+	    name: string;
+	    notes: string;
+	    splits: TransactionSplitInput[];
+	
+	    static createFrom(source: any = {}) {
+	        return new TransactionInsertInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.account_id = source["account_id"];
+	        this.period_id = source["period_id"];
+	        this.category_id = source["category_id"];
+	        this.date = source["date"];
+	        this.amount = source["amount"];
+	        this.name = source["name"];
+	        this.notes = source["notes"];
+	        this.splits = this.convertValues(source["splits"], TransactionSplitInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -58,20 +2262,20 @@ export namespace types {
 		    return a;
 		}
 	}
-	export class AccountListResult {
+	export class TransactionListResult {
 	    success: boolean;
 	    message: string;
-	    data: Account[];
+	    data: Transaction[];
 	
 	    static createFrom(source: any = {}) {
-	        return new AccountListResult(source);
+	        return new TransactionListResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.success = source["success"];
 	        this.message = source["message"];
-	        this.data = this.convertValues(source["data"], Account);
+	        this.data = this.convertValues(source["data"], Transaction);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -92,20 +2296,22 @@ export namespace types {
 		    return a;
 		}
 	}
-	export class AccountResult {
-	    success: boolean;
-	    message: string;
-	    data: Account;
+	export class TransactionMatch {
+	    transaction: Transaction;
+	    name_highlight: string;
+	    notes_snippet: string;
+	    rank: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new AccountResult(source);
+	        return new TransactionMatch(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.success = source["success"];
-	        this.message = source["message"];
-	        this.data = this.convertValues(source["data"], Account);
+	        this.transaction = this.convertValues(source["transaction"], Transaction);
+	        this.name_highlight = source["name_highlight"];
+	        this.notes_snippet = source["notes_snippet"];
+	        this.rank = source["rank"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -126,64 +2332,54 @@ export namespace types {
 		    return a;
 		}
 	}
-	export class AccountUpdateInput {
-	    name: string;
-	    period_start_day: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new AccountUpdateInput(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.name = source["name"];
-	        this.period_start_day = source["period_start_day"];
-	    }
-	}
-	export class Category {
-	    id: number;
-	    name: string;
-	    color: string;
+	export class TransactionMatchListResult {
+	    success: boolean;
+	    message: string;
+	    data: TransactionMatch[];
 	
 	    static createFrom(source: any = {}) {
-	        return new Category(source);
+	        return new TransactionMatchListResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.name = source["name"];
-	        this.color = source["color"];
-	    }
-	}
-	export class CategoryInsertInput {
-	    name: string;
-	    color: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new CategoryInsertInput(source);
+	        this.success = source["success"];
+	        this.message = source["message"];
+	        this.data = this.convertValues(source["data"], TransactionMatch);
 	    }
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.name = source["name"];
-	        this.color = source["color"];
-	    }
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class CategoryListResult {
+	export class TransactionResult {
 	    success: boolean;
 	    message: string;
-	    data: Category[];
+	    data: Transaction;
 	
 	    static createFrom(source: any = {}) {
-	        return new CategoryListResult(source);
+	        return new TransactionResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.success = source["success"];
 	        this.message = source["message"];
-	        this.data = this.convertValues(source["data"], Category);
+	        this.data = this.convertValues(source["data"], Transaction);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -204,20 +2400,18 @@ export namespace types {
 		    return a;
 		}
 	}
-	export class CategoryResult {
-	    success: boolean;
-	    message: string;
-	    data: Category;
+	export class TransactionSearch {
+	    transactions: Transaction[];
+	    total_count: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new CategoryResult(source);
+	        return new TransactionSearch(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.success = source["success"];
-	        this.message = source["message"];
-	        this.data = this.convertValues(source["data"], Category);
+	        this.transactions = this.convertValues(source["transactions"], Transaction);
+	        this.total_count = source["total_count"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -238,37 +2432,54 @@ export namespace types {
 		    return a;
 		}
 	}
-	export class CategoryUpdateInput {
-	    id: number;
-	    name: string;
-	    color: string;
+	export class TransactionSearchInput {
+	    account_id: number;
+	    text: string;
+	    min_amount?: number;
+	    max_amount?: number;
+	    start_date: number;
+	    end_date: number;
+	    category_ids: number[];
+	    origin: string;
+	    sort_by: string;
+	    sort_desc: boolean;
+	    limit: number;
+	    offset: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new CategoryUpdateInput(source);
+	        return new TransactionSearchInput(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.name = source["name"];
-	        this.color = source["color"];
+	        this.account_id = source["account_id"];
+	        this.text = source["text"];
+	        this.min_amount = source["min_amount"];
+	        this.max_amount = source["max_amount"];
+	        this.start_date = source["start_date"];
+	        this.end_date = source["end_date"];
+	        this.category_ids = source["category_ids"];
+	        this.origin = source["origin"];
+	        this.sort_by = source["sort_by"];
+	        this.sort_desc = source["sort_desc"];
+	        this.limit = source["limit"];
+	        this.offset = source["offset"];
 	    }
 	}
-	
-	export class PeriodResult {
+	export class TransactionSearchResult {
 	    success: boolean;
 	    message: string;
-	    data: Period;
+	    data: TransactionSearch;
 	
 	    static createFrom(source: any = {}) {
-	        return new PeriodResult(source);
+	        return new TransactionSearchResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.success = source["success"];
 	        this.message = source["message"];
-	        this.data = this.convertValues(source["data"], Period);
+	        this.data = this.convertValues(source["data"], TransactionSearch);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -289,62 +2500,80 @@ export namespace types {
 		    return a;
 		}
 	}
-	export class Recurring {
+	
+	
+	export class TransactionStatusInput {
 	    id: number;
-	    name: string;
-	    amount: number;
-	    category_id: number;
-	    day: number;
-	    accounted_for: boolean;
+	    status: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new Recurring(source);
+	        return new TransactionStatusInput(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.name = source["name"];
-	        this.amount = source["amount"];
-	        this.category_id = source["category_id"];
-	        this.day = source["day"];
-	        this.accounted_for = source["accounted_for"];
+	        this.status = source["status"];
 	    }
 	}
-	export class RecurringInput {
+	export class TransactionUpdateInput {
 	    id: number;
+	    date: number;
 	    amount: number;
 	    category_id: number;
 	    name: string;
-	    day: number;
+	    notes?: string;
+	    splits?: TransactionSplitInput[];
+	    clear_splits: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new RecurringInput(source);
+	        return new TransactionUpdateInput(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
+	        this.date = source["date"];
 	        this.amount = source["amount"];
 	        this.category_id = source["category_id"];
 	        this.name = source["name"];
-	        this.day = source["day"];
+	        this.notes = source["notes"];
+	        this.splits = this.convertValues(source["splits"], TransactionSplitInput);
+	        this.clear_splits = source["clear_splits"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class RecurringListResult {
-	    success: boolean;
-	    message: string;
-	    data: Recurring[];
+	export class Transfer {
+	    id: number;
+	    from: Transaction;
+	    to: Transaction;
 	
 	    static createFrom(source: any = {}) {
-	        return new RecurringListResult(source);
+	        return new Transfer(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.success = source["success"];
-	        this.message = source["message"];
-	        this.data = this.convertValues(source["data"], Recurring);
+	        this.id = source["id"];
+	        this.from = this.convertValues(source["from"], Transaction);
+	        this.to = this.convertValues(source["to"], Transaction);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -365,20 +2594,42 @@ export namespace types {
 		    return a;
 		}
 	}
-	export class RecurringResult {
+	export class TransferInput {
+	    from_account_id: number;
+	    to_account_id: number;
+	    amount: number;
+	    date: number;
+	    name: string;
+	    notes: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TransferInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.from_account_id = source["from_account_id"];
+	        this.to_account_id = source["to_account_id"];
+	        this.amount = source["amount"];
+	        this.date = source["date"];
+	        this.name = source["name"];
+	        this.notes = source["notes"];
+	    }
+	}
+	export class TransferResult {
 	    success: boolean;
 	    message: string;
-	    data: Recurring;
+	    data: Transfer;
 	
 	    static createFrom(source: any = {}) {
-	        return new RecurringResult(source);
+	        return new TransferResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.success = source["success"];
 	        this.message = source["message"];
-	        this.data = this.convertValues(source["data"], Recurring);
+	        this.data = this.convertValues(source["data"], Transfer);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -399,80 +2650,50 @@ export namespace types {
 		    return a;
 		}
 	}
-	export class SimpleResult {
-	    success: boolean;
-	    message: string;
+	export class TrendInput {
+	    account_id: number;
+	    periods: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new SimpleResult(source);
+	        return new TrendInput(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.success = source["success"];
-	        this.message = source["message"];
+	        this.account_id = source["account_id"];
+	        this.periods = source["periods"];
 	    }
 	}
-	export class Transaction {
+	export class TrendPeriod {
 	    id: number;
-	    category_id: number;
-	    date: number;
-	    display_date: string;
-	    amount: number;
-	    name: string;
-	    from_recurring_id: number;
+	    reporting_start: string;
+	    reporting_end: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new Transaction(source);
+	        return new TrendPeriod(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.category_id = source["category_id"];
-	        this.date = source["date"];
-	        this.display_date = source["display_date"];
-	        this.amount = source["amount"];
-	        this.name = source["name"];
-	        this.from_recurring_id = source["from_recurring_id"];
+	        this.reporting_start = source["reporting_start"];
+	        this.reporting_end = source["reporting_end"];
 	    }
 	}
-	export class TransactionInsertInput {
+	export class TrendReport {
 	    account_id: number;
-	    period_id: number;
-	    category_id: number;
-	    date: number;
-	    amount: number;
-	    name: string;
+	    periods: TrendPeriod[];
+	    categories: CategoryTrend[];
 	
 	    static createFrom(source: any = {}) {
-	        return new TransactionInsertInput(source);
+	        return new TrendReport(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.account_id = source["account_id"];
-	        this.period_id = source["period_id"];
-	        this.category_id = source["category_id"];
-	        this.date = source["date"];
-	        this.amount = source["amount"];
-	        this.name = source["name"];
-	    }
-	}
-	export class TransactionListResult {
-	    success: boolean;
-	    message: string;
-	    data: Transaction[];
-	
-	    static createFrom(source: any = {}) {
-	        return new TransactionListResult(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.success = source["success"];
-	        this.message = source["message"];
-	        this.data = this.convertValues(source["data"], Transaction);
+	        this.periods = this.convertValues(source["periods"], TrendPeriod);
+	        this.categories = this.convertValues(source["categories"], CategoryTrend);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -493,20 +2714,20 @@ export namespace types {
 		    return a;
 		}
 	}
-	export class TransactionResult {
+	export class TrendReportResult {
 	    success: boolean;
 	    message: string;
-	    data: Transaction;
+	    data: TrendReport;
 	
 	    static createFrom(source: any = {}) {
-	        return new TransactionResult(source);
+	        return new TrendReportResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.success = source["success"];
 	        this.message = source["message"];
-	        this.data = this.convertValues(source["data"], Transaction);
+	        this.data = this.convertValues(source["data"], TrendReport);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -527,26 +2748,6 @@ export namespace types {
 		    return a;
 		}
 	}
-	export class TransactionUpdateInput {
-	    id: number;
-	    date: number;
-	    amount: number;
-	    category_id: number;
-	    name: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new TransactionUpdateInput(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.date = source["date"];
-	        this.amount = source["amount"];
-	        this.category_id = source["category_id"];
-	        this.name = source["name"];
-	    }
-	}
 
 }
 
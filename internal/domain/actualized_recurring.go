@@ -6,8 +6,15 @@ type ActualizedRecurring struct {
 	Id        int64
 	AccountId int64
 	PeriodId  int64
+	BasedOnId int64
 	Name      string
 	Amount    int64
 	Day       uint8
-	Date      time.Time
+	// Amount is the recurring's amount when it was actualized and
+	// ActualAmount what the transaction actually came to.
+	ActualAmount int64
+	Date         time.Time
+	// Occurrence is the scheduled date the actualization accounts for. It is
+	// zero for recurrings actualized before recurrence rules existed.
+	Occurrence time.Time
 }
@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// Budget is the amount planned for spending in a category during a period.
+type Budget struct {
+	Id         int64
+	AccountId  int64
+	PeriodId   int64
+	CategoryId int64
+	Amount     int64
+}
+
+// BudgetLine compares a category's budget with what was spent in the period.
+// Available is Budgeted plus whatever rolled over from the previous period.
+// Spent is the category's net outflow, so refunds and income reduce it, and
+// Percent is Spent as a percentage of Available, 0 when nothing is available.
+type BudgetLine struct {
+	Category
+	Budgeted  int64
+	CarriedIn int64
+	Available int64
+	Spent     int64
+	Remaining int64
+	Percent   float64
+}
+
+// BudgetRollover records what a rollover category carried from one period
+// into the next. Negative amounts carry a deficit.
+type BudgetRollover struct {
+	Id           int64
+	AccountId    int64
+	CategoryId   int64
+	FromPeriodId int64
+	ToPeriodId   int64
+	PeriodStart  time.Time
+	Amount       int64
+	CreatedOn    time.Time
+}
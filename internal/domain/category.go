@@ -5,4 +5,36 @@ type Category struct {
 	AccountId int64
 	Name      string
 	Color     string
+	// Rollover carries what is left of the category's budget, or the amount
+	// it went over, into the next period.
+	Rollover bool
+	Kind     string
+}
+
+// A category's kind decides whether its transactions count as income or
+// expense in reports. Auto categories go by the sign of each amount.
+const (
+	CategoryKindAuto    = "auto"
+	CategoryKindIncome  = "income"
+	CategoryKindExpense = "expense"
+)
+
+// IsIncome reports whether an amount filed under the category is income.
+func (c Category) IsIncome(amount int64) bool {
+	switch c.Kind {
+	case CategoryKindIncome:
+		return true
+	case CategoryKindExpense:
+		return false
+	default:
+		return amount > 0
+	}
+}
+
+// CategoryTotal sums a category's share of a period's transactions. Split
+// transactions count toward each split's category instead of their own.
+type CategoryTotal struct {
+	Category
+	Amount int64
+	Count  int
 }
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// Forecast projects an account's balance day by day from its active period
+// balance, applying every recurring occurrence not yet accounted for.
+type Forecast struct {
+	AccountId     int64
+	StartBalance  int64
+	Days          []ForecastDay
+	LowestBalance int64
+	LowestDate    time.Time
+}
+
+// ForecastDay is the projected balance at the end of a day and the
+// recurrings expected that day.
+type ForecastDay struct {
+	Date    time.Time
+	Balance int64
+	Events  []ForecastEvent
+}
+
+type ForecastEvent struct {
+	RecurringId int64
+	Name        string
+	Amount      int64
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// ImportRow is a single parsed line of an import file. Err is set when the
+// line could not be turned into a transaction and Duplicate when its external
+// id was already imported. CategoryName is set by formats that carry their own
+// categories and is resolved to a CategoryId on import. Splits holds the
+// lines of a split entry until their categories are resolved into
+// Transaction.Splits.
+// ImportRow is one parsed entry. An OpeningBalance row is not inserted; its
+// amount adjusts the account's opening balances instead.
+type ImportRow struct {
+	Line           int
+	Transaction    Transaction
+	CategoryName   string
+	Splits         []ImportSplit
+	OpeningBalance bool
+	Duplicate      bool
+	Err            error
+}
+
+type ImportSplit struct {
+	CategoryName string
+	Amount       int64
+	Memo         string
+}
+
+type ImportBatch struct {
+	Id         int64
+	AccountId  int64
+	Source     string
+	FileName   string
+	ImportedOn time.Time
+}
+
+type ExportFile struct {
+	FileName string
+	Content  string
+}
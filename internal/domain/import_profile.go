@@ -0,0 +1,18 @@
+package domain
+
+// ImportProfile describes how to read one bank's CSV export. Column numbers
+// are 1-based and 0 means the column is not present in the file.
+type ImportProfile struct {
+	Id            int64
+	AccountId     int64
+	Name          string
+	Delimiter     string
+	DateFormat    string
+	HeaderRows    int
+	DateColumn    int
+	NameColumn    int
+	AmountColumn  int
+	DebitColumn   int
+	CreditColumn  int
+	NegateAmounts bool
+}
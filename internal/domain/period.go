@@ -10,4 +10,29 @@ type Period struct {
 	OpenedOn       time.Time
 	ClosedOn       time.Time
 	Balance        int64
+	// ClearedBalance and WorkingBalance run from the account's first opening
+	// balance through the end of this period. Cleared only counts cleared and
+	// reconciled transactions, working counts every transaction.
+	ClearedBalance int64
+	WorkingBalance int64
+	// AutoApplied lists the transactions created from auto-apply recurrings
+	// when the period was opened. It is only set on newly started periods.
+	AutoApplied []Transaction
+}
+
+// PeriodSummary totals a period's transactions. Inflow and Outflow exclude the
+// opening balance and Outflow is negative, so
+// OpeningBalance + Inflow + Outflow == Balance.
+type PeriodSummary struct {
+	Period
+	OpeningBalance int64
+	Inflow         int64
+	Outflow        int64
+}
+
+// Noon returns noon UTC on t's calendar day in UTC, the time of day period
+// boundaries are stored at.
+func Noon(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 12, 0, 0, 0, time.UTC)
 }
@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// Reconciliation compares the account against a bank statement. ClearedBalance
+// is the account's cleared balance as of the statement date and the
+// reconciliation can only be finalized once Difference is zero.
+type Reconciliation struct {
+	Id               int64
+	AccountId        int64
+	StatementDate    time.Time
+	StatementBalance int64
+	StartedOn        time.Time
+	FinalizedOn      time.Time
+	ClearedBalance   int64
+	Difference       int64
+	// Transactions are the ones still to tick off, every unreconciled
+	// transaction dated on or before the statement date.
+	Transactions []Transaction
+}
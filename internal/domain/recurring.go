@@ -1,5 +1,18 @@
 package domain
 
+import "time"
+
+const (
+	FrequencyWeekly  = "weekly"
+	FrequencyMonthly = "monthly"
+	FrequencyYearly  = "yearly"
+)
+
+// Recurring is a template for a transaction that repeats every Interval
+// weeks, months or years starting at Anchor. Weekly recurrings fall on
+// Weekday, monthly and yearly ones on Day, clamped to the end of short
+// months. Until and Count optionally end the rule; zero values mean it never
+// ends. AutoApply recurrings are actualized as soon as a period opens.
 type Recurring struct {
 	Id                int64
 	AccountId         int64
@@ -7,5 +20,94 @@ type Recurring struct {
 	Name              string
 	Day               uint8
 	Amount            int64
+	Frequency         string
+	Interval          int
+	Anchor            time.Time
+	Until             time.Time
+	Count             int
+	Weekday           time.Weekday
+	AutoApply         bool
 	AccountedInPeriod bool
+	Occurrences       []RecurringOccurrence
+}
+
+// RecurringOccurrence is one date a recurring falls on within a period and
+// the actualized recurring that accounted for it, if any.
+type RecurringOccurrence struct {
+	Date                  time.Time
+	ActualizedRecurringId int64
+}
+
+func (o RecurringOccurrence) Actualized() bool {
+	return o.ActualizedRecurringId != 0
+}
+
+// OccurrencesBetween lists the dates from and to, inclusive, that the
+// recurring falls on. Dates are at noon UTC like period boundaries.
+// Recurrings without an anchor predate recurrence rules and fall on Day of
+// every month.
+func (r Recurring) OccurrencesBetween(from time.Time, to time.Time) []time.Time {
+	from, to = Noon(from), Noon(to)
+	interval := max(r.Interval, 1)
+
+	anchor := Noon(r.Anchor)
+	if r.Anchor.IsZero() {
+		anchor = time.Date(from.Year(), from.Month(), 1, 12, 0, 0, 0, time.UTC)
+	}
+
+	var until time.Time
+	if !r.Until.IsZero() {
+		until = Noon(r.Until)
+	}
+
+	dates := make([]time.Time, 0, 5)
+	n := 0
+	for k := 0; ; k++ {
+		d := r.nth(anchor, k*interval)
+		if d.Before(anchor) {
+			continue
+		}
+		if d.After(to) || (!until.IsZero() && d.After(until)) {
+			break
+		}
+
+		n++
+		if r.Count > 0 && n > r.Count {
+			break
+		}
+		if !d.Before(from) {
+			dates = append(dates, d)
+		}
+	}
+
+	return dates
+}
+
+// nth returns the candidate date the given number of weeks, months or years
+// after the anchor.
+func (r Recurring) nth(anchor time.Time, step int) time.Time {
+	switch r.Frequency {
+	case FrequencyWeekly:
+		first := anchor.AddDate(0, 0, (int(r.Weekday)-int(anchor.Weekday())+7)%7)
+		return first.AddDate(0, 0, 7*step)
+	case FrequencyYearly:
+		return onDay(anchor.Year()+step, anchor.Month(), r.day(anchor))
+	default:
+		return onDay(anchor.Year(), anchor.Month()+time.Month(step), r.day(anchor))
+	}
+}
+
+func (r Recurring) day(anchor time.Time) int {
+	if r.Day == 0 {
+		return anchor.Day()
+	}
+	return int(r.Day)
+}
+
+// onDay returns the day of the month, or the month's last day when it is
+// shorter.
+func onDay(year int, month time.Month, day int) time.Time {
+	first := time.Date(year, month, 1, 12, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(day, last)-1)
 }
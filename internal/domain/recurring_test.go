@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+}
+
+func TestOccurrencesBetween(t *testing.T) {
+	tests := []struct {
+		name      string
+		recurring Recurring
+		from      time.Time
+		to        time.Time
+		want      []string
+	}{
+		{
+			name:      "legacy monthly without anchor",
+			recurring: Recurring{Day: 15},
+			from:      date(2026, time.January, 1),
+			to:        date(2026, time.March, 31),
+			want:      []string{"2026-01-15", "2026-02-15", "2026-03-15"},
+		},
+		{
+			name:      "legacy day clamped to month end",
+			recurring: Recurring{Day: 31},
+			from:      date(2026, time.January, 1),
+			to:        date(2026, time.April, 30),
+			want:      []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30"},
+		},
+		{
+			name:      "monthly clamps without drifting",
+			recurring: Recurring{Frequency: FrequencyMonthly, Day: 31, Anchor: date(2026, time.January, 31)},
+			from:      date(2026, time.January, 1),
+			to:        date(2026, time.May, 31),
+			want:      []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30", "2026-05-31"},
+		},
+		{
+			name:      "monthly day before anchor day starts the next month",
+			recurring: Recurring{Frequency: FrequencyMonthly, Day: 5, Anchor: date(2026, time.January, 20)},
+			from:      date(2026, time.January, 1),
+			to:        date(2026, time.March, 31),
+			want:      []string{"2026-02-05", "2026-03-05"},
+		},
+		{
+			name:      "quarterly",
+			recurring: Recurring{Frequency: FrequencyMonthly, Interval: 3, Day: 15, Anchor: date(2026, time.January, 15)},
+			from:      date(2026, time.January, 1),
+			to:        date(2026, time.December, 31),
+			want:      []string{"2026-01-15", "2026-04-15", "2026-07-15", "2026-10-15"},
+		},
+		{
+			name:      "yearly on leap day",
+			recurring: Recurring{Frequency: FrequencyYearly, Day: 29, Anchor: date(2024, time.February, 29)},
+			from:      date(2024, time.January, 1),
+			to:        date(2028, time.December, 31),
+			want:      []string{"2024-02-29", "2025-02-28", "2026-02-28", "2027-02-28", "2028-02-29"},
+		},
+		{
+			name:      "biweekly on a weekday",
+			recurring: Recurring{Frequency: FrequencyWeekly, Interval: 2, Weekday: time.Friday, Anchor: date(2026, time.January, 5)},
+			from:      date(2026, time.January, 1),
+			to:        date(2026, time.February, 10),
+			want:      []string{"2026-01-09", "2026-01-23", "2026-02-06"},
+		},
+		{
+			name:      "weekly on the anchor's weekday",
+			recurring: Recurring{Frequency: FrequencyWeekly, Weekday: time.Monday, Anchor: date(2026, time.January, 5)},
+			from:      date(2026, time.January, 10),
+			to:        date(2026, time.January, 26),
+			want:      []string{"2026-01-12", "2026-01-19", "2026-01-26"},
+		},
+		{
+			name:      "count ends the rule",
+			recurring: Recurring{Frequency: FrequencyMonthly, Day: 10, Count: 3, Anchor: date(2026, time.January, 10)},
+			from:      date(2026, time.January, 1),
+			to:        date(2026, time.December, 31),
+			want:      []string{"2026-01-10", "2026-02-10", "2026-03-10"},
+		},
+		{
+			name:      "count includes occurrences before from",
+			recurring: Recurring{Frequency: FrequencyMonthly, Day: 10, Count: 3, Anchor: date(2026, time.January, 10)},
+			from:      date(2026, time.February, 1),
+			to:        date(2026, time.December, 31),
+			want:      []string{"2026-02-10", "2026-03-10"},
+		},
+		{
+			name:      "until is inclusive",
+			recurring: Recurring{Frequency: FrequencyMonthly, Day: 10, Anchor: date(2026, time.January, 10), Until: date(2026, time.March, 10)},
+			from:      date(2026, time.January, 1),
+			to:        date(2026, time.December, 31),
+			want:      []string{"2026-01-10", "2026-02-10", "2026-03-10"},
+		},
+		{
+			name:      "until before an occurrence",
+			recurring: Recurring{Frequency: FrequencyMonthly, Day: 10, Anchor: date(2026, time.January, 10), Until: date(2026, time.March, 9)},
+			from:      date(2026, time.January, 1),
+			to:        date(2026, time.December, 31),
+			want:      []string{"2026-01-10", "2026-02-10"},
+		},
+		{
+			name:      "anchor after the range",
+			recurring: Recurring{Frequency: FrequencyMonthly, Day: 1, Anchor: date(2027, time.January, 1)},
+			from:      date(2026, time.January, 1),
+			to:        date(2026, time.December, 31),
+			want:      []string{},
+		},
+		{
+			name:      "bounds ignore time of day",
+			recurring: Recurring{Frequency: FrequencyMonthly, Day: 10, Anchor: date(2026, time.January, 10)},
+			from:      time.Date(2026, time.January, 10, 23, 59, 0, 0, time.UTC),
+			to:        time.Date(2026, time.February, 10, 0, 0, 0, 0, time.UTC),
+			want:      []string{"2026-01-10", "2026-02-10"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0, len(tt.want))
+			for _, d := range tt.recurring.OccurrencesBetween(tt.from, tt.to) {
+				got = append(got, d.Format(time.DateOnly))
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("OccurrencesBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
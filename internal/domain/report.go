@@ -0,0 +1,123 @@
+package domain
+
+import "time"
+
+// SpendingReport totals an account's transactions by category over a period
+// or date range. Outflows are negative. Share is the category's percentage of
+// TotalOutflow.
+type SpendingReport struct {
+	AccountId    int64
+	PeriodId     int64
+	From         time.Time
+	To           time.Time
+	Categories   []CategorySpending
+	TotalInflow  int64
+	TotalOutflow int64
+}
+
+type CategorySpending struct {
+	Category
+	Inflow  int64
+	Outflow int64
+	Count   int
+	Share   float64
+}
+
+// IncomeExpenseReport compares income with expense over a period or date
+// range, per period and rolled up per calendar year. Transfers and opening
+// balances are not counted.
+type IncomeExpenseReport struct {
+	AccountId int64
+	PeriodId  int64
+	From      time.Time
+	To        time.Time
+	Periods   []IncomeExpense
+	Years     []IncomeExpense
+	Total     IncomeExpense
+}
+
+// IncomeExpense is one row of an IncomeExpenseReport. Expense is positive,
+// Savings is Income less Expense and SavingsRate is Savings as a percentage
+// of Income, 0 when there is no income. Period rows set PeriodId, Start and
+// End; year rows set Year.
+type IncomeExpense struct {
+	PeriodId    int64
+	Start       time.Time
+	End         time.Time
+	Year        int
+	Income      int64
+	Expense     int64
+	Savings     int64
+	SavingsRate float64
+}
+
+// TrendReport follows each category's spending across consecutive periods,
+// oldest first. Spent is the net outflow, so refunds reduce it.
+type TrendReport struct {
+	AccountId  int64
+	Periods    []Period
+	Categories []CategoryTrend
+}
+
+// CategoryTrend has one entry in Spent and Deltas per period of the report.
+// Each delta is the change from the period before, 0 for the first.
+type CategoryTrend struct {
+	Category
+	Spent   []int64
+	Deltas  []int64
+	Total   int64
+	Average float64
+	Min     int64
+	Max     int64
+}
+
+// NetWorth is the combined balance of every account at a series of dates.
+// ByPeriod is set when the accounts share period boundaries and the dates
+// are period ends; otherwise they are calendar month ends.
+type NetWorth struct {
+	ByPeriod bool
+	Points   []NetWorthPoint
+}
+
+type NetWorthPoint struct {
+	Date     time.Time
+	Total    int64
+	Accounts []AccountBalance
+}
+
+type AccountBalance struct {
+	AccountId int64
+	Name      string
+	Balance   int64
+}
+
+// DailyChange is the net of an account's transactions dated on one day.
+type DailyChange struct {
+	AccountId int64
+	Date      time.Time
+	Amount    int64
+}
+
+// BalanceSeries is an account's running balance through a period, starting
+// from its opening balance. Entries follow the transactions in date order and
+// Days has the end-of-day balance for every day of the period.
+type BalanceSeries struct {
+	AccountId      int64
+	Period         Period
+	OpeningBalance int64
+	Entries        []BalanceEntry
+	Days           []DailyBalance
+}
+
+// BalanceEntry is a transaction and the balance after it.
+type BalanceEntry struct {
+	Transaction
+	Balance int64
+}
+
+type DailyBalance struct {
+	Date    time.Time
+	Balance int64
+	Change  int64
+	Count   int
+}
@@ -8,8 +8,34 @@ type Transaction struct {
 	PeriodId              int64
 	CategoryId            int64
 	Name                  string
+	Notes                 string
 	Amount                int64
 	ActualizedRecurringId int64
 	Date                  time.Time
 	CanDelete             bool
+	ExternalId            string
+	ImportBatchId         int64
+	TransferId            int64
+	Status                string
+	Splits                []TransactionSplit
+}
+
+// A transaction starts uncleared, is cleared once it shows up on a statement
+// and is reconciled when a reconciliation against that statement is
+// finalized. Reconciled transactions can no longer be edited or deleted.
+const (
+	StatusUncleared  = "uncleared"
+	StatusCleared    = "cleared"
+	StatusReconciled = "reconciled"
+)
+
+// TransactionSplit divides a transaction between categories. When a
+// transaction has splits their amounts add up to its amount and they replace
+// its own category in category totals.
+type TransactionSplit struct {
+	Id            int64
+	TransactionId int64
+	CategoryId    int64
+	Amount        int64
+	Memo          string
 }
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+const (
+	OriginAny       = ""
+	OriginRecurring = "recurring"
+	OriginManual    = "manual"
+	OriginTransfer  = "transfer"
+)
+
+// TransactionFilter narrows a transaction search. Zero values leave a
+// condition out, so an empty filter matches every transaction.
+type TransactionFilter struct {
+	AccountId   int64
+	Text        string
+	MinAmount   *int64
+	MaxAmount   *int64
+	StartDate   time.Time
+	EndDate     time.Time
+	CategoryIds []int64
+	Origin      string
+	SortBy      string
+	SortDesc    bool
+	Limit       int
+	Offset      int
+}
+
+// TransactionMatch is a full text search hit. NameHighlight and NotesSnippet
+// are HTML escaped with matched terms wrapped in <mark> tags.
+type TransactionMatch struct {
+	Transaction
+	NameHighlight string
+	NotesSnippet  string
+	Rank          float64
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// Transfer links the debit in the account money leaves with the matching
+// credit in the account it arrives in. Both transactions carry its id.
+type Transfer struct {
+	Id            int64
+	FromAccountId int64
+	ToAccountId   int64
+	CreatedOn     time.Time
+}
@@ -0,0 +1,13 @@
+package exporter
+
+import "fmt"
+
+// FormatAmount renders cents as a plain decimal such as -1234.50.
+func FormatAmount(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
@@ -0,0 +1,75 @@
+package exporter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// WriteBeancount writes entries as beancount directives. Beancount has no
+// posting level assertions, so assertions become balance directives, which
+// check the balance at the start of their day, and zero postings are dropped.
+func WriteBeancount(out io.Writer, entries []JournalEntry) error {
+	w := bufio.NewWriter(out)
+
+	fmt.Fprintf(w, "option \"operating_currency\" \"%s\"\n\n", Commodity)
+
+	opened := make(map[string]bool)
+	var openDate time.Time
+	accounts := make([]string, 0, 20)
+	for _, e := range entries {
+		if openDate.IsZero() || e.Date.Before(openDate) {
+			openDate = e.Date
+		}
+		for _, p := range e.Postings {
+			if !opened[p.Account] {
+				opened[p.Account] = true
+				accounts = append(accounts, p.Account)
+			}
+		}
+	}
+
+	for _, account := range accounts {
+		fmt.Fprintf(w, "%s open %s\n", openDate.Format("2006-01-02"), account)
+	}
+	fmt.Fprintln(w)
+
+	for _, e := range entries {
+		date := e.Date.Format("2006-01-02")
+
+		postings := make([]Posting, 0, len(e.Postings))
+		for _, p := range e.Postings {
+			if p.Assertion != nil && p.Amount == 0 && !p.Elided {
+				fmt.Fprintf(w, "%s balance %s  %s\n\n", date, p.Account, formatCommodity(*p.Assertion))
+				continue
+			}
+			postings = append(postings, p)
+		}
+
+		if len(postings) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(w, "%s * %s %s\n", date, beancountString(e.Payee), beancountString(e.Notes))
+		for _, p := range postings {
+			if p.Elided {
+				fmt.Fprintf(w, "  %s\n", p.Account)
+				continue
+			}
+			fmt.Fprintf(w, "  %-40s  %s\n", p.Account, formatCommodity(p.Amount))
+		}
+		fmt.Fprintln(w)
+	}
+
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("write beancount: %w", err)
+	}
+	return nil
+}
+
+func beancountString(value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(journalText(value))
+	return `"` + escaped + `"`
+}
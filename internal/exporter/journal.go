@@ -0,0 +1,176 @@
+package exporter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+const (
+	EquityOpeningAccount = "Equity:Opening-Balances"
+	// EquityTransferAccount carries both sides of a transfer so each side
+	// only posts to its own account and the pair nets to zero.
+	EquityTransferAccount = "Equity:Transfers"
+	Commodity             = "USD"
+)
+
+// Posting is one leg of a journal entry. The last posting of an entry may
+// leave its amount elided and Assertion, when set, is the balance the posting's
+// account must hold after it.
+type Posting struct {
+	Account   string
+	Amount    int64
+	Elided    bool
+	Assertion *int64
+}
+
+type JournalEntry struct {
+	Date     time.Time
+	Payee    string
+	Notes    string
+	Postings []Posting
+}
+
+// JournalAccount is one app account with every one of its transactions,
+// ordered the way TransactionRepo.ListForPeriods returns them.
+type JournalAccount struct {
+	Account      domain.Account
+	Transactions []domain.Transaction
+}
+
+// BuildJournal turns each transaction dated within [start, end] into a
+// balanced entry against its category. A zero start or end leaves that side
+// open. The first entry of each account opens it from equity with the balance
+// carried into the range and every later period's Opening Balance becomes a
+// balance assertion at the period boundary.
+func BuildJournal(accounts []JournalAccount, categories map[int64]domain.Category, start time.Time, end time.Time) []JournalEntry {
+	entries := make([]JournalEntry, 0, 100)
+
+	for _, ja := range accounts {
+		asset := AssetAccountName(ja.Account)
+		var balance int64
+		opened := false
+
+		for _, t := range ja.Transactions {
+			inRange := (start.IsZero() || !t.Date.Before(start)) && (end.IsZero() || !t.Date.After(end))
+
+			if !t.CanDelete {
+				balance = t.Amount
+				if !inRange {
+					continue
+				}
+
+				assertion := balance
+				if !opened && balance != 0 {
+					entries = append(entries, openingEntry(t.Date, asset, balance))
+				} else {
+					entries = append(entries, JournalEntry{
+						Date:     t.Date,
+						Payee:    t.Name,
+						Postings: []Posting{{Account: asset, Amount: 0, Assertion: &assertion}},
+					})
+				}
+				opened = true
+				continue
+			}
+
+			if !inRange {
+				if !start.IsZero() && t.Date.Before(start) {
+					balance += t.Amount
+				}
+				continue
+			}
+
+			if !opened {
+				if balance != 0 {
+					entries = append(entries, openingEntry(start, asset, balance))
+				}
+				opened = true
+			}
+
+			balance += t.Amount
+			entries = append(entries, JournalEntry{
+				Date:     t.Date,
+				Payee:    t.Name,
+				Notes:    t.Notes,
+				Postings: transactionPostings(t, asset, categories),
+			})
+		}
+	}
+
+	return entries
+}
+
+// transactionPostings balances the asset posting against the transaction's
+// category, or against each split's category when it is split. Transfers
+// balance against EquityTransferAccount.
+func transactionPostings(t domain.Transaction, asset string, categories map[int64]domain.Category) []Posting {
+	if t.TransferId != 0 {
+		return []Posting{
+			{Account: asset, Amount: t.Amount},
+			{Account: EquityTransferAccount, Amount: -t.Amount, Elided: true},
+		}
+	}
+
+	if len(t.Splits) == 0 {
+		return []Posting{
+			{Account: asset, Amount: t.Amount},
+			{Account: CategoryAccountName(categories[t.CategoryId], t.Amount), Amount: -t.Amount, Elided: true},
+		}
+	}
+
+	postings := make([]Posting, 0, len(t.Splits)+1)
+	postings = append(postings, Posting{Account: asset, Amount: t.Amount})
+	for _, s := range t.Splits {
+		postings = append(postings, Posting{Account: CategoryAccountName(categories[s.CategoryId], s.Amount), Amount: -s.Amount})
+	}
+	return postings
+}
+
+func openingEntry(date time.Time, asset string, balance int64) JournalEntry {
+	assertion := balance
+	return JournalEntry{
+		Date:  date,
+		Payee: "Opening Balance",
+		Postings: []Posting{
+			{Account: asset, Amount: balance, Assertion: &assertion},
+			{Account: EquityOpeningAccount, Amount: -balance, Elided: true},
+		},
+	}
+}
+
+func AssetAccountName(a domain.Account) string {
+	return "Assets:" + accountComponent(a.Name, "Account")
+}
+
+// CategoryAccountName files income under Income and expenses under Expenses,
+// going by the category's kind or, for auto categories, the amount's sign.
+func CategoryAccountName(c domain.Category, amount int64) string {
+	root := "Expenses:"
+	if c.IsIncome(amount) {
+		root = "Income:"
+	}
+	return root + accountComponent(c.Name, "Uncategorized")
+}
+
+// accountComponent makes a name safe for every journal format. Beancount is
+// the strictest and wants each component to start with a capital letter or
+// digit and contain only letters, digits and dashes.
+func accountComponent(name string, fallback string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	if len(words) == 0 {
+		return fallback
+	}
+
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, "-")
+}
+
+func formatCommodity(cents int64) string {
+	return fmt.Sprintf("%s %s", FormatAmount(cents), Commodity)
+}
@@ -0,0 +1,45 @@
+package exporter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteLedger writes entries in the journal syntax shared by ledger and
+// hledger. Balance assertions use the `= AMOUNT` posting form both support.
+func WriteLedger(out io.Writer, entries []JournalEntry) error {
+	w := bufio.NewWriter(out)
+
+	for _, e := range entries {
+		fmt.Fprintf(w, "%s %s\n", e.Date.Format("2006-01-02"), journalText(e.Payee))
+		if e.Notes != "" {
+			fmt.Fprintf(w, "    ; %s\n", journalText(e.Notes))
+		}
+
+		for _, p := range e.Postings {
+			if p.Elided {
+				fmt.Fprintf(w, "    %s\n", p.Account)
+				continue
+			}
+
+			line := fmt.Sprintf("    %-40s  %s", p.Account, formatCommodity(p.Amount))
+			if p.Assertion != nil {
+				line += " = " + formatCommodity(*p.Assertion)
+			}
+			fmt.Fprintln(w, line)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("write ledger: %w", err)
+	}
+	return nil
+}
+
+// journalText keeps a payee or note on a single line.
+func journalText(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
@@ -0,0 +1,55 @@
+package exporter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+// WriteQIF writes the transactions as a single !Type:Bank section. Opening
+// balances are written the way Quicken does, as a transfer from the account
+// itself, and transfers name the account on the other side, looked up by
+// transfer id in transferAccounts.
+func WriteQIF(out io.Writer, account domain.Account, transactions []domain.Transaction, categoryNames map[int64]string, transferAccounts map[int64]string) error {
+	w := bufio.NewWriter(out)
+
+	fmt.Fprintln(w, "!Type:Bank")
+	for _, t := range transactions {
+		fmt.Fprintf(w, "D%s\n", t.Date.Format("01/02/2006"))
+		fmt.Fprintf(w, "T%s\n", FormatAmount(t.Amount))
+		fmt.Fprintf(w, "P%s\n", qifValue(t.Name))
+		if t.Notes != "" {
+			fmt.Fprintf(w, "M%s\n", qifValue(t.Notes))
+		}
+
+		if !t.CanDelete {
+			fmt.Fprintf(w, "L[%s]\n", qifValue(account.Name))
+		} else if other, ok := transferAccounts[t.TransferId]; ok && t.TransferId != 0 {
+			fmt.Fprintf(w, "L[%s]\n", qifValue(other))
+		} else if name, ok := categoryNames[t.CategoryId]; ok && len(t.Splits) == 0 {
+			fmt.Fprintf(w, "L%s\n", qifValue(name))
+		}
+
+		for _, s := range t.Splits {
+			fmt.Fprintf(w, "S%s\n", qifValue(categoryNames[s.CategoryId]))
+			if s.Memo != "" {
+				fmt.Fprintf(w, "E%s\n", qifValue(s.Memo))
+			}
+			fmt.Fprintf(w, "$%s\n", FormatAmount(s.Amount))
+		}
+
+		fmt.Fprintln(w, "^")
+	}
+
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("write qif: %w", err)
+	}
+	return nil
+}
+
+// qifValue keeps a value on one line since QIF fields are line delimited.
+func qifValue(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
@@ -0,0 +1,53 @@
+package importer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseAmount converts a statement amount such as "$1,234.56", "-12.3" or
+// "(12.00)" into cents.
+func ParseAmount(value string) (int64, error) {
+	s := strings.TrimSpace(value)
+	negative := false
+
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = s[1 : len(s)-1]
+	}
+	if strings.HasSuffix(s, "-") {
+		negative = !negative
+		s = strings.TrimSuffix(s, "-")
+	}
+
+	s = strings.NewReplacer("$", "", ",", "", " ", "").Replace(s)
+	if strings.HasPrefix(s, "-") {
+		negative = !negative
+		s = s[1:]
+	} else {
+		s = strings.TrimPrefix(s, "+")
+	}
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" && fraction == "" {
+		return 0, fmt.Errorf("parse amount %q: empty", value)
+	}
+	if len(fraction) > 2 {
+		return 0, fmt.Errorf("parse amount %q: more than two decimal places", value)
+	}
+	fraction = fraction + strings.Repeat("0", 2-len(fraction))
+	if whole == "" {
+		whole = "0"
+	}
+
+	cents, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil || strings.ContainsAny(whole+fraction, "+-") {
+		return 0, fmt.Errorf("parse amount %q: invalid number", value)
+	}
+
+	if negative {
+		cents = -cents
+	}
+	return cents, nil
+}
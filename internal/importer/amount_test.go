@@ -0,0 +1,47 @@
+package importer
+
+import "testing"
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{value: "12.34", want: 1234},
+		{value: "-12.3", want: -1230},
+		{value: "+5", want: 500},
+		{value: ".5", want: 50},
+		{value: "$1,234.56", want: 123456},
+		{value: "-$1,234.56", want: -123456},
+		{value: "(12.00)", want: -1200},
+		{value: "12.00-", want: -1200},
+		{value: "(12.00-)", want: 1200},
+		{value: " 7 ", want: 700},
+		{value: "0", want: 0},
+		{value: "", wantErr: true},
+		{value: ".", wantErr: true},
+		{value: "1.234", wantErr: true},
+		{value: "abc", wantErr: true},
+		{value: "1-2", wantErr: true},
+		{value: "--5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseAmount(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseAmount(%q) = %d, want an error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAmount(%q) error: %s", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseAmount(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
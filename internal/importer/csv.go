@@ -0,0 +1,176 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+var ErrorInvalidProfile = errors.New("invalid import profile")
+
+// ValidateProfile checks that the profile names every column needed to build
+// a transaction.
+func ValidateProfile(p domain.ImportProfile) error {
+	if len([]rune(p.Delimiter)) > 1 {
+		return fmt.Errorf("%w: delimiter must be a single character", ErrorInvalidProfile)
+	}
+	if p.DateColumn <= 0 || p.NameColumn <= 0 {
+		return fmt.Errorf("%w: date and name columns are required", ErrorInvalidProfile)
+	}
+	if p.AmountColumn <= 0 && p.DebitColumn <= 0 && p.CreditColumn <= 0 {
+		return fmt.Errorf("%w: an amount column or debit/credit columns are required", ErrorInvalidProfile)
+	}
+	if p.HeaderRows < 0 {
+		return fmt.Errorf("%w: header rows can't be negative", ErrorInvalidProfile)
+	}
+	return nil
+}
+
+// ParseCSV reads a bank statement using the profile. Lines that fail to parse
+// are returned with Err set so they can be shown in a preview.
+func ParseCSV(in io.Reader, p domain.ImportProfile) ([]domain.ImportRow, error) {
+	if err := ValidateProfile(p); err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(in)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.LazyQuotes = true
+	if p.Delimiter != "" {
+		reader.Comma = []rune(p.Delimiter)[0]
+	}
+
+	layout := DateLayout(p.DateFormat)
+	rows := make([]domain.ImportRow, 0, 100)
+	line := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return rows, fmt.Errorf("read csv: %w", err)
+		}
+
+		line++
+		if line <= p.HeaderRows || isBlank(record) {
+			continue
+		}
+
+		t, err := parseRecord(record, p, layout)
+		rows = append(rows, domain.ImportRow{Line: line, Transaction: t, Err: err})
+	}
+
+	return rows, nil
+}
+
+func parseRecord(record []string, p domain.ImportProfile, layout string) (domain.Transaction, error) {
+	var t domain.Transaction
+
+	dateValue, err := column(record, p.DateColumn)
+	if err != nil {
+		return t, err
+	}
+	t.Date, err = ParseDate(layout, dateValue)
+	if err != nil {
+		return t, err
+	}
+
+	t.Name, err = column(record, p.NameColumn)
+	if err != nil {
+		return t, err
+	}
+
+	if p.AmountColumn > 0 {
+		value, err := column(record, p.AmountColumn)
+		if err != nil {
+			return t, err
+		}
+		t.Amount, err = ParseAmount(value)
+		if err != nil {
+			return t, err
+		}
+	} else {
+		debit, err := optionalAmount(record, p.DebitColumn)
+		if err != nil {
+			return t, err
+		}
+		credit, err := optionalAmount(record, p.CreditColumn)
+		if err != nil {
+			return t, err
+		}
+		t.Amount = abs(credit) - abs(debit)
+	}
+
+	if p.NegateAmounts {
+		t.Amount = -t.Amount
+	}
+
+	return t, nil
+}
+
+func column(record []string, number int) (string, error) {
+	if number > len(record) {
+		return "", fmt.Errorf("missing column %d", number)
+	}
+	return strings.TrimSpace(record[number-1]), nil
+}
+
+func optionalAmount(record []string, number int) (int64, error) {
+	if number <= 0 || number > len(record) {
+		return 0, nil
+	}
+	value := strings.TrimSpace(record[number-1])
+	if value == "" {
+		return 0, nil
+	}
+	return ParseAmount(value)
+}
+
+func isBlank(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// DateLayout converts a user facing format such as MM/DD/YYYY into a Go time
+// layout. An empty format defaults to YYYY-MM-DD.
+func DateLayout(format string) string {
+	if format == "" {
+		format = "YYYY-MM-DD"
+	}
+	replacer := strings.NewReplacer(
+		"YYYY", "2006",
+		"YY", "06",
+		"MM", "01",
+		"DD", "02",
+		"M", "1",
+		"D", "2",
+	)
+	return replacer.Replace(strings.ToUpper(format))
+}
+
+// ParseDate parses a statement date and places it at noon UTC, the same time
+// of day periods are stored with.
+func ParseDate(layout string, value string) (time.Time, error) {
+	d, err := time.Parse(layout, value)
+	if err != nil {
+		return d, fmt.Errorf("parse date %q: %w", value, err)
+	}
+	return time.Date(d.Year(), d.Month(), d.Day(), 12, 0, 0, 0, time.UTC), nil
+}
@@ -0,0 +1,124 @@
+package importer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+func TestDateLayout(t *testing.T) {
+	tests := map[string]string{
+		"":           "2006-01-02",
+		"MM/DD/YYYY": "01/02/2006",
+		"m/d/yy":     "1/2/06",
+		"DD.MM.YYYY": "02.01.2006",
+	}
+
+	for format, want := range tests {
+		if got := DateLayout(format); got != want {
+			t.Errorf("DateLayout(%q) = %q, want %q", format, got, want)
+		}
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	type row struct {
+		line   int
+		date   string
+		name   string
+		amount int64
+		err    bool
+	}
+
+	tests := []struct {
+		name    string
+		profile domain.ImportProfile
+		content string
+		want    []row
+	}{
+		{
+			name:    "amount column with header",
+			profile: domain.ImportProfile{HeaderRows: 1, DateColumn: 1, NameColumn: 2, AmountColumn: 3, DateFormat: "MM/DD/YYYY"},
+			content: "Date,Description,Amount\n01/05/2026,Coffee,-4.50\n01/06/2026,\"Pay, Inc\",\"1,200.00\"\n",
+			want: []row{
+				{line: 2, date: "2026-01-05", name: "Coffee", amount: -450},
+				{line: 3, date: "2026-01-06", name: "Pay, Inc", amount: 120000},
+			},
+		},
+		{
+			name:    "debit and credit columns",
+			profile: domain.ImportProfile{DateColumn: 1, NameColumn: 2, DebitColumn: 3, CreditColumn: 4},
+			content: "2026-01-05,Coffee,4.50,\n2026-01-06,Refund,,12.00\n",
+			want: []row{
+				{line: 1, date: "2026-01-05", name: "Coffee", amount: -450},
+				{line: 2, date: "2026-01-06", name: "Refund", amount: 1200},
+			},
+		},
+		{
+			name:    "semicolon delimiter and negated amounts",
+			profile: domain.ImportProfile{Delimiter: ";", DateColumn: 1, NameColumn: 2, AmountColumn: 3, NegateAmounts: true},
+			content: "2026-01-05;Card payment;25.00\n",
+			want: []row{
+				{line: 1, date: "2026-01-05", name: "Card payment", amount: -2500},
+			},
+		},
+		{
+			name:    "blank lines skipped and bad lines kept with an error",
+			profile: domain.ImportProfile{DateColumn: 1, NameColumn: 2, AmountColumn: 3},
+			content: "2026-01-05,Coffee,-4.50\n,,\n01/06/2026,Bad date,1.00\n2026-01-07,Missing amount\n2026-01-08,Bad amount,abc\n",
+			want: []row{
+				{line: 1, date: "2026-01-05", name: "Coffee", amount: -450},
+				{line: 3, err: true},
+				{line: 4, err: true},
+				{line: 5, err: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ParseCSV(strings.NewReader(tt.content), tt.profile)
+			if err != nil {
+				t.Fatalf("ParseCSV error: %s", err)
+			}
+			if len(rows) != len(tt.want) {
+				t.Fatalf("ParseCSV returned %d rows, want %d", len(rows), len(tt.want))
+			}
+
+			for i, want := range tt.want {
+				got := rows[i]
+				if got.Line != want.line {
+					t.Errorf("row %d line = %d, want %d", i, got.Line, want.line)
+				}
+				if want.err {
+					if got.Err == nil {
+						t.Errorf("row %d has no error", i)
+					}
+					continue
+				}
+				if got.Err != nil {
+					t.Errorf("row %d error: %s", i, got.Err)
+					continue
+				}
+				if d := got.Transaction.Date.Format(time.DateOnly); d != want.date {
+					t.Errorf("row %d date = %s, want %s", i, d, want.date)
+				}
+				if got.Transaction.Name != want.name {
+					t.Errorf("row %d name = %q, want %q", i, got.Transaction.Name, want.name)
+				}
+				if got.Transaction.Amount != want.amount {
+					t.Errorf("row %d amount = %d, want %d", i, got.Transaction.Amount, want.amount)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCSVRejectsIncompleteProfile(t *testing.T) {
+	_, err := ParseCSV(strings.NewReader(""), domain.ImportProfile{DateColumn: 1, NameColumn: 2})
+	if !errors.Is(err, ErrorInvalidProfile) {
+		t.Errorf("ParseCSV error = %v, want %v", err, ErrorInvalidProfile)
+	}
+}
@@ -0,0 +1,92 @@
+package importer
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+// OFX 1.x is SGML where leaf elements are not closed, OFX 2.x is XML. Both
+// close the STMTTRN aggregate and put a leaf's value directly after its
+// opening tag, so one set of patterns reads either version.
+var stmtTrnPattern = regexp.MustCompile(`(?is)<STMTTRN>(.*?)</STMTTRN>`)
+
+var ofxFieldPatterns = map[string]*regexp.Regexp{
+	"FITID":    ofxFieldPattern("FITID"),
+	"DTPOSTED": ofxFieldPattern("DTPOSTED"),
+	"TRNAMT":   ofxFieldPattern("TRNAMT"),
+	"NAME":     ofxFieldPattern("NAME"),
+	"PAYEE":    ofxFieldPattern("PAYEE"),
+	"MEMO":     ofxFieldPattern("MEMO"),
+}
+
+func ofxFieldPattern(tag string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)<` + tag + `>([^<\r\n]*)`)
+}
+
+func ofxField(block string, tag string) string {
+	match := ofxFieldPatterns[tag].FindStringSubmatch(block)
+	if match == nil {
+		return ""
+	}
+	return strings.TrimSpace(html.UnescapeString(match[1]))
+}
+
+// ParseOFX reads the STMTTRN entries of an OFX or QFX statement. The bank's
+// FITID is stored as the transaction's ExternalId.
+func ParseOFX(in io.Reader) ([]domain.ImportRow, error) {
+	content, err := io.ReadAll(in)
+	if err != nil {
+		return nil, fmt.Errorf("read ofx: %w", err)
+	}
+
+	if !strings.Contains(strings.ToUpper(string(content)), "<OFX>") {
+		return nil, fmt.Errorf("read ofx: no OFX element found")
+	}
+
+	blocks := stmtTrnPattern.FindAllStringSubmatch(string(content), -1)
+	rows := make([]domain.ImportRow, 0, len(blocks))
+	for i, block := range blocks {
+		t, err := parseStmtTrn(block[1])
+		rows = append(rows, domain.ImportRow{Line: i + 1, Transaction: t, Err: err})
+	}
+
+	return rows, nil
+}
+
+func parseStmtTrn(block string) (domain.Transaction, error) {
+	var t domain.Transaction
+
+	t.ExternalId = ofxField(block, "FITID")
+	if t.ExternalId == "" {
+		return t, fmt.Errorf("missing FITID")
+	}
+
+	posted := ofxField(block, "DTPOSTED")
+	if len(posted) < 8 {
+		return t, fmt.Errorf("invalid DTPOSTED %q", posted)
+	}
+	date, err := ParseDate("20060102", posted[:8])
+	if err != nil {
+		return t, err
+	}
+	t.Date = date
+
+	t.Amount, err = ParseAmount(ofxField(block, "TRNAMT"))
+	if err != nil {
+		return t, err
+	}
+
+	t.Name = ofxField(block, "NAME")
+	if t.Name == "" {
+		t.Name = ofxField(block, "PAYEE")
+	}
+	if t.Name == "" {
+		t.Name = ofxField(block, "MEMO")
+	}
+
+	return t, nil
+}
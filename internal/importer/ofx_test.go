@@ -0,0 +1,122 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const ofxSGML = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS><BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20260105120000[-5:EST]
+<TRNAMT>-4.50
+<FITID>1001
+<NAME>Coffee &amp; Co
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20260106
+<TRNAMT>1200.00
+<FITID>1002
+<MEMO>Payroll
+</STMTTRN>
+</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+const ofxXML = `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS><BANKTRANLIST>
+<STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20260107</DTPOSTED><TRNAMT>-25.00</TRNAMT><FITID>2001</FITID><PAYEE>Grocer</PAYEE></STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>2026</DTPOSTED><TRNAMT>-1.00</TRNAMT><FITID>2002</FITID><NAME>Bad date</NAME></STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20260108</DTPOSTED><TRNAMT>-1.00</TRNAMT><NAME>No id</NAME></STMTTRN>
+</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>
+`
+
+func TestParseOFX(t *testing.T) {
+	type row struct {
+		externalId string
+		date       string
+		name       string
+		amount     int64
+		err        bool
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    []row
+	}{
+		{
+			name:    "sgml",
+			content: ofxSGML,
+			want: []row{
+				{externalId: "1001", date: "2026-01-05", name: "Coffee & Co", amount: -450},
+				{externalId: "1002", date: "2026-01-06", name: "Payroll", amount: 120000},
+			},
+		},
+		{
+			name:    "xml",
+			content: ofxXML,
+			want: []row{
+				{externalId: "2001", date: "2026-01-07", name: "Grocer", amount: -2500},
+				{err: true},
+				{err: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ParseOFX(strings.NewReader(tt.content))
+			if err != nil {
+				t.Fatalf("ParseOFX error: %s", err)
+			}
+			if len(rows) != len(tt.want) {
+				t.Fatalf("ParseOFX returned %d rows, want %d", len(rows), len(tt.want))
+			}
+
+			for i, want := range tt.want {
+				got := rows[i]
+				if got.Line != i+1 {
+					t.Errorf("row %d line = %d, want %d", i, got.Line, i+1)
+				}
+				if want.err {
+					if got.Err == nil {
+						t.Errorf("row %d has no error", i)
+					}
+					continue
+				}
+				if got.Err != nil {
+					t.Errorf("row %d error: %s", i, got.Err)
+					continue
+				}
+				if got.Transaction.ExternalId != want.externalId {
+					t.Errorf("row %d external id = %q, want %q", i, got.Transaction.ExternalId, want.externalId)
+				}
+				if d := got.Transaction.Date.Format(time.DateOnly); d != want.date {
+					t.Errorf("row %d date = %s, want %s", i, d, want.date)
+				}
+				if got.Transaction.Name != want.name {
+					t.Errorf("row %d name = %q, want %q", i, got.Transaction.Name, want.name)
+				}
+				if got.Transaction.Amount != want.amount {
+					t.Errorf("row %d amount = %d, want %d", i, got.Transaction.Amount, want.amount)
+				}
+			}
+		})
+	}
+}
+
+func TestParseOFXRequiresOFXElement(t *testing.T) {
+	_, err := ParseOFX(strings.NewReader("Date,Name,Amount\n"))
+	if err == nil {
+		t.Error("ParseOFX accepted a file without an OFX element")
+	}
+}
@@ -0,0 +1,189 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+// qifDateLayouts are tried in order when no date format is given. Quicken
+// writes years after 1999 with an apostrophe, e.g. 1/ 2'26.
+var qifDateLayouts = []string{
+	"1/2/2006",
+	"1/2/06",
+	"2006-01-02",
+	"1-2-2006",
+	"1-2-06",
+}
+
+type qifSplit struct {
+	category string
+	memo     string
+	amount   string
+}
+
+type qifEntry struct {
+	line     int
+	date     string
+	amount   string
+	payee    string
+	memo     string
+	category string
+	splits   []qifSplit
+}
+
+// ParseQIF reads the !Type:Bank and !Type:CCard sections of a QIF file. Other
+// sections are skipped. A split entry becomes one row with a line per split.
+// Quicken's opening balance entry is marked OpeningBalance.
+func ParseQIF(in io.Reader, dateFormat string) ([]domain.ImportRow, error) {
+	scanner := bufio.NewScanner(in)
+	rows := make([]domain.ImportRow, 0, 100)
+
+	supported := false
+	entry := qifEntry{}
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		if strings.HasPrefix(text, "!") {
+			header := strings.ToLower(strings.TrimSpace(text))
+			if strings.HasPrefix(header, "!type:") {
+				kind := strings.TrimPrefix(header, "!type:")
+				supported = kind == "bank" || kind == "ccard" || kind == "cash"
+			} else {
+				supported = false
+			}
+			entry = qifEntry{}
+			continue
+		}
+
+		if entry.line == 0 {
+			entry.line = line
+		}
+
+		code, value := text[0], strings.TrimSpace(text[1:])
+		switch code {
+		case 'D':
+			entry.date = value
+		case 'T', 'U':
+			entry.amount = value
+		case 'P':
+			entry.payee = value
+		case 'M':
+			entry.memo = value
+		case 'L':
+			entry.category = value
+		case 'S':
+			entry.splits = append(entry.splits, qifSplit{category: value})
+		case 'E':
+			if len(entry.splits) > 0 {
+				entry.splits[len(entry.splits)-1].memo = value
+			}
+		case '$':
+			if len(entry.splits) > 0 {
+				entry.splits[len(entry.splits)-1].amount = value
+			}
+		case '^':
+			if supported {
+				rows = append(rows, qifRow(entry, dateFormat))
+			}
+			entry = qifEntry{}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return rows, fmt.Errorf("read qif: %w", err)
+	}
+
+	return rows, nil
+}
+
+// qifRow turns an entry into a row. A split entry's own category is ignored
+// since Quicken writes a placeholder there. Without a total its amount is the sum of its lines.
+func qifRow(entry qifEntry, dateFormat string) domain.ImportRow {
+	row := domain.ImportRow{
+		Line:           entry.line,
+		Transaction:    domain.Transaction{Name: entry.payee, Notes: entry.memo},
+		OpeningBalance: qifOpeningBalance(entry),
+	}
+	if row.Transaction.Name == "" {
+		row.Transaction.Name = entry.memo
+	}
+
+	date, err := parseQIFDate(entry.date, dateFormat)
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	row.Transaction.Date = date
+
+	if len(entry.splits) == 0 {
+		row.Transaction.Amount, row.Err = ParseAmount(entry.amount)
+		row.CategoryName = qifCategory(entry.category)
+		return row
+	}
+
+	var sum int64
+	row.Splits = make([]domain.ImportSplit, 0, len(entry.splits))
+	for i, split := range entry.splits {
+		amount, err := ParseAmount(split.amount)
+		if err != nil {
+			row.Err = fmt.Errorf("split %d: %w", i+1, err)
+			return row
+		}
+
+		sum += amount
+		row.Splits = append(row.Splits, domain.ImportSplit{
+			CategoryName: qifCategory(split.category),
+			Amount:       amount,
+			Memo:         split.memo,
+		})
+	}
+
+	row.Transaction.Amount = sum
+	if entry.amount != "" {
+		row.Transaction.Amount, row.Err = ParseAmount(entry.amount)
+	}
+
+	return row
+}
+
+// qifOpeningBalance reports whether the entry is Quicken's opening balance,
+// written as a transfer from the account itself.
+func qifOpeningBalance(entry qifEntry) bool {
+	return strings.EqualFold(entry.payee, "Opening Balance") && strings.HasPrefix(entry.category, "[")
+}
+
+// qifCategory drops the /class suffix and returns "" for transfers, which
+// QIF writes as [Account Name].
+func qifCategory(value string) string {
+	category, _, _ := strings.Cut(value, "/")
+	category = strings.TrimSpace(category)
+	if strings.HasPrefix(category, "[") {
+		return ""
+	}
+	return category
+}
+
+func parseQIFDate(value string, dateFormat string) (time.Time, error) {
+	normalized := strings.ReplaceAll(strings.ReplaceAll(value, "'", "/"), " ", "")
+
+	if dateFormat != "" {
+		return ParseDate(DateLayout(dateFormat), normalized)
+	}
+
+	for _, layout := range qifDateLayouts {
+		if d, err := ParseDate(layout, normalized); err == nil {
+			return d, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("parse date %q: unrecognized format", value)
+}
@@ -0,0 +1,152 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+const qifFile = `!Type:Bank
+D1/ 2'26
+T100.00
+POpening Balance
+L[Checking]
+^
+D1/ 5'26
+T-4.50
+PCoffee
+MMorning
+LDining:Coffee/Work
+^
+D01/06/2026
+T-50.00
+PTo savings
+L[Savings]
+^
+D1/7/26
+T-30.00
+PMarket
+L--Split--
+SGroceries
+$-20.00
+SHousehold
+ESoap
+$-10.00
+^
+!Account
+NSavings
+^
+!Type:Invst
+D1/8/26
+T-99.00
+PIgnored
+^
+!Type:CCard
+D2026-01-09
+U-12.00
+PGas
+LAuto
+^
+`
+
+func TestParseQIF(t *testing.T) {
+	rows, err := ParseQIF(strings.NewReader(qifFile), "")
+	if err != nil {
+		t.Fatalf("ParseQIF error: %s", err)
+	}
+
+	want := []struct {
+		date           string
+		name           string
+		notes          string
+		amount         int64
+		category       string
+		splits         []domain.ImportSplit
+		openingBalance bool
+	}{
+		{date: "2026-01-02", name: "Opening Balance", amount: 10000, openingBalance: true},
+		{date: "2026-01-05", name: "Coffee", notes: "Morning", amount: -450, category: "Dining:Coffee"},
+		{date: "2026-01-06", name: "To savings", amount: -5000},
+		{date: "2026-01-07", name: "Market", amount: -3000, splits: []domain.ImportSplit{
+			{CategoryName: "Groceries", Amount: -2000},
+			{CategoryName: "Household", Amount: -1000, Memo: "Soap"},
+		}},
+		{date: "2026-01-09", name: "Gas", amount: -1200, category: "Auto"},
+	}
+
+	if len(rows) != len(want) {
+		t.Fatalf("ParseQIF returned %d rows, want %d", len(rows), len(want))
+	}
+
+	for i, w := range want {
+		got := rows[i]
+		if got.Err != nil {
+			t.Errorf("row %d error: %s", i, got.Err)
+			continue
+		}
+		if d := got.Transaction.Date.Format(time.DateOnly); d != w.date {
+			t.Errorf("row %d date = %s, want %s", i, d, w.date)
+		}
+		if got.Transaction.Name != w.name {
+			t.Errorf("row %d name = %q, want %q", i, got.Transaction.Name, w.name)
+		}
+		if got.Transaction.Notes != w.notes {
+			t.Errorf("row %d notes = %q, want %q", i, got.Transaction.Notes, w.notes)
+		}
+		if got.Transaction.Amount != w.amount {
+			t.Errorf("row %d amount = %d, want %d", i, got.Transaction.Amount, w.amount)
+		}
+		if got.OpeningBalance != w.openingBalance {
+			t.Errorf("row %d opening balance = %t, want %t", i, got.OpeningBalance, w.openingBalance)
+		}
+		if got.CategoryName != w.category {
+			t.Errorf("row %d category = %q, want %q", i, got.CategoryName, w.category)
+		}
+		if len(got.Splits) != len(w.splits) {
+			t.Errorf("row %d has %d splits, want %d", i, len(got.Splits), len(w.splits))
+			continue
+		}
+		for j := range w.splits {
+			if got.Splits[j] != w.splits[j] {
+				t.Errorf("row %d split %d = %+v, want %+v", i, j, got.Splits[j], w.splits[j])
+			}
+		}
+	}
+}
+
+func TestParseQIFRowErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		dateFormat string
+	}{
+		{name: "bad date", content: "!Type:Bank\nDyesterday\nT1.00\nPx\n^\n"},
+		{name: "date not in given format", content: "!Type:Bank\nD01/02/2026\nT1.00\nPx\n^\n", dateFormat: "YYYY-MM-DD"},
+		{name: "bad amount", content: "!Type:Bank\nD01/02/2026\nTabc\nPx\n^\n"},
+		{name: "bad split amount", content: "!Type:Bank\nD01/02/2026\nT-1.00\nPx\nSA\n$abc\nSB\n$-1.00\n^\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ParseQIF(strings.NewReader(tt.content), tt.dateFormat)
+			if err != nil {
+				t.Fatalf("ParseQIF error: %s", err)
+			}
+			if len(rows) != 1 || rows[0].Err == nil {
+				t.Errorf("ParseQIF = %+v, want one row with an error", rows)
+			}
+		})
+	}
+}
+
+func TestParseQIFSplitWithoutTotal(t *testing.T) {
+	content := "!Type:Bank\nD01/02/2026\nPx\nSA\n$-1.25\nSB\n$-2.00\n^\n"
+	rows, err := ParseQIF(strings.NewReader(content), "")
+	if err != nil {
+		t.Fatalf("ParseQIF error: %s", err)
+	}
+	if len(rows) != 1 || rows[0].Transaction.Amount != -325 {
+		t.Errorf("ParseQIF = %+v, want one row of -325", rows)
+	}
+}
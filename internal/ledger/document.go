@@ -0,0 +1,178 @@
+package ledger
+
+// FormatVersion is bumped whenever the document layout changes. Documents
+// with a newer version than this build understands are rejected on import.
+//
+//	1 initial layout
+//	2 transaction_splits
+//	3 transfers and transactions.transfer_id
+//	4 reconciliations, transactions.status and transactions.reconciliation_id
+//	5 budgets
+//	6 budget_rollovers and categories.rollover
+//	7 recurrence rules on recurrings and actualized_recurrings.occurrence_timestamp
+//	8 recurrings.auto_apply
+//	9 actualized_recurrings.actual_amount
+//	10 categories.kind
+const FormatVersion = 10
+
+// Document is a complete copy of the database. Every row keeps its original
+// id and every timestamp is stored as unix milliseconds, exactly as the
+// database stores them, so an import restores the ledger without loss.
+type Document struct {
+	Version              int                   `json:"version"`
+	SchemaVersion        int                   `json:"schema_version"`
+	ExportedOn           int64                 `json:"exported_on"`
+	Accounts             []Account             `json:"accounts"`
+	Periods              []Period              `json:"periods"`
+	Categories           []Category            `json:"categories"`
+	Budgets              []Budget              `json:"budgets"`
+	BudgetRollovers      []BudgetRollover      `json:"budget_rollovers"`
+	Recurrings           []Recurring           `json:"recurrings"`
+	ActualizedRecurrings []ActualizedRecurring `json:"actualized_recurrings"`
+	ImportProfiles       []ImportProfile       `json:"import_profiles"`
+	ImportBatches        []ImportBatch         `json:"import_batches"`
+	Transfers            []Transfer            `json:"transfers"`
+	Reconciliations      []Reconciliation      `json:"reconciliations"`
+	Transactions         []Transaction         `json:"transactions"`
+	TransactionSplits    []TransactionSplit    `json:"transaction_splits"`
+}
+
+type Account struct {
+	Id             int64  `json:"id"`
+	Name           string `json:"name"`
+	PeriodStartDay uint8  `json:"period_start_day"`
+	CanDelete      bool   `json:"can_delete"`
+}
+
+type Period struct {
+	Id             int64  `json:"id"`
+	AccountId      int64  `json:"account_id"`
+	ReportingStart int64  `json:"reporting_start"`
+	ReportingEnd   int64  `json:"reporting_end"`
+	OpenedOn       int64  `json:"opened_on"`
+	ClosedOn       *int64 `json:"closed_on"`
+}
+
+type Category struct {
+	Id        int64  `json:"id"`
+	AccountId int64  `json:"account_id"`
+	Name      string `json:"name"`
+	Color     string `json:"color"`
+	Rollover  bool   `json:"rollover"`
+	Kind      string `json:"kind"`
+}
+
+type Budget struct {
+	Id         int64 `json:"id"`
+	AccountId  int64 `json:"account_id"`
+	PeriodId   int64 `json:"period_id"`
+	CategoryId int64 `json:"category_id"`
+	Amount     int64 `json:"amount"`
+}
+
+type BudgetRollover struct {
+	Id           int64 `json:"id"`
+	AccountId    int64 `json:"account_id"`
+	CategoryId   int64 `json:"category_id"`
+	FromPeriodId int64 `json:"from_period_id"`
+	ToPeriodId   int64 `json:"to_period_id"`
+	Amount       int64 `json:"amount"`
+	CreatedOn    int64 `json:"timestamp_created"`
+}
+
+type Recurring struct {
+	Id         int64  `json:"id"`
+	AccountId  int64  `json:"account_id"`
+	CategoryId int64  `json:"category_id"`
+	Name       string `json:"name"`
+	Day        uint8  `json:"occurrence_day"`
+	Amount     int64  `json:"amount"`
+	Frequency  string `json:"frequency"`
+	Interval   int    `json:"interval_count"`
+	Anchor     *int64 `json:"anchor_timestamp"`
+	End        *int64 `json:"end_timestamp"`
+	Count      int    `json:"occurrence_count"`
+	Weekday    int    `json:"weekday"`
+	AutoApply  bool   `json:"auto_apply"`
+	AddedOn    int64  `json:"timestamp_added"`
+}
+
+type ActualizedRecurring struct {
+	Id               int64  `json:"id"`
+	AccountId        int64  `json:"account_id"`
+	PeriodId         int64  `json:"period_id"`
+	BasedOnId        int64  `json:"based_on_id"`
+	CategorySnapshot string `json:"category_snapshot"`
+	NameSnapshot     string `json:"name_snapshot"`
+	DaySnapshot      uint8  `json:"occurrence_day_snapshot"`
+	AmountSnapshot   int64  `json:"amount_snapshot"`
+	ActualAmount     *int64 `json:"actual_amount"`
+	Occurrence       *int64 `json:"occurrence_timestamp"`
+	CreatedOn        int64  `json:"timestamp_created"`
+}
+
+type ImportProfile struct {
+	Id            int64  `json:"id"`
+	AccountId     int64  `json:"account_id"`
+	Name          string `json:"name"`
+	Delimiter     string `json:"delimiter"`
+	DateFormat    string `json:"date_format"`
+	HeaderRows    int    `json:"header_rows"`
+	DateColumn    int    `json:"date_column"`
+	NameColumn    int    `json:"name_column"`
+	AmountColumn  int    `json:"amount_column"`
+	DebitColumn   int    `json:"debit_column"`
+	CreditColumn  int    `json:"credit_column"`
+	NegateAmounts bool   `json:"negate_amounts"`
+}
+
+type ImportBatch struct {
+	Id         int64  `json:"id"`
+	AccountId  int64  `json:"account_id"`
+	Source     string `json:"source"`
+	FileName   string `json:"file_name"`
+	ImportedOn int64  `json:"timestamp_imported"`
+}
+
+type Transaction struct {
+	Id                    int64  `json:"id"`
+	AccountId             int64  `json:"account_id"`
+	PeriodId              int64  `json:"period_id"`
+	CategoryId            int64  `json:"category_id"`
+	ActualizedRecurringId int64  `json:"actualized_recurring_id"`
+	ImportBatchId         int64  `json:"import_batch_id"`
+	Date                  int64  `json:"transaction_date"`
+	Amount                int64  `json:"amount"`
+	Name                  string `json:"name"`
+	Notes                 string `json:"notes"`
+	ExternalId            string `json:"external_id"`
+	TransferId            int64  `json:"transfer_id"`
+	ReconciliationId      int64  `json:"reconciliation_id"`
+	Status                string `json:"status"`
+	CanDelete             bool   `json:"can_delete"`
+	AddedOn               int64  `json:"timestamp_added"`
+}
+
+type Transfer struct {
+	Id            int64 `json:"id"`
+	FromAccountId int64 `json:"from_account_id"`
+	ToAccountId   int64 `json:"to_account_id"`
+	CreatedOn     int64 `json:"timestamp_created"`
+}
+
+type TransactionSplit struct {
+	Id            int64  `json:"id"`
+	TransactionId int64  `json:"transaction_id"`
+	CategoryId    int64  `json:"category_id"`
+	Amount        int64  `json:"amount"`
+	Memo          string `json:"memo"`
+}
+
+type Reconciliation struct {
+	Id               int64  `json:"id"`
+	AccountId        int64  `json:"account_id"`
+	StatementDate    int64  `json:"statement_date"`
+	StatementBalance int64  `json:"statement_balance"`
+	StartedOn        int64  `json:"timestamp_started"`
+	FinalizedOn      *int64 `json:"timestamp_finalized"`
+}
@@ -0,0 +1,214 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrorIntegrity = errors.New("ledger integrity")
+
+// Verify checks that ids are unique within each table and that every
+// reference points at a row in the document. A zero id means no reference.
+// Actualized recurrings may outlive the recurring they were based on, so
+// based_on_id is not checked.
+func Verify(doc Document) error {
+	if doc.Version < 1 || doc.Version > FormatVersion {
+		return fmt.Errorf("%w: unsupported format version %d", ErrorIntegrity, doc.Version)
+	}
+
+	accounts := make(map[int64]bool, len(doc.Accounts))
+	for _, a := range doc.Accounts {
+		if err := unique(accounts, "account", a.Id); err != nil {
+			return err
+		}
+	}
+
+	periods := make(map[int64]bool, len(doc.Periods))
+	for _, p := range doc.Periods {
+		if err := unique(periods, "period", p.Id); err != nil {
+			return err
+		}
+		if err := exists(accounts, "period", p.Id, "account", p.AccountId); err != nil {
+			return err
+		}
+	}
+
+	categories := make(map[int64]bool, len(doc.Categories))
+	for _, c := range doc.Categories {
+		if err := unique(categories, "category", c.Id); err != nil {
+			return err
+		}
+		if err := exists(accounts, "category", c.Id, "account", c.AccountId); err != nil {
+			return err
+		}
+	}
+
+	budgets := make(map[int64]bool, len(doc.Budgets))
+	for _, b := range doc.Budgets {
+		if err := unique(budgets, "budget", b.Id); err != nil {
+			return err
+		}
+		if err := exists(accounts, "budget", b.Id, "account", b.AccountId); err != nil {
+			return err
+		}
+		if err := exists(periods, "budget", b.Id, "period", b.PeriodId); err != nil {
+			return err
+		}
+		if err := exists(categories, "budget", b.Id, "category", b.CategoryId); err != nil {
+			return err
+		}
+	}
+
+	rollovers := make(map[int64]bool, len(doc.BudgetRollovers))
+	for _, ro := range doc.BudgetRollovers {
+		if err := unique(rollovers, "budget rollover", ro.Id); err != nil {
+			return err
+		}
+		if err := exists(accounts, "budget rollover", ro.Id, "account", ro.AccountId); err != nil {
+			return err
+		}
+		if err := exists(categories, "budget rollover", ro.Id, "category", ro.CategoryId); err != nil {
+			return err
+		}
+		if err := exists(periods, "budget rollover", ro.Id, "period", ro.FromPeriodId); err != nil {
+			return err
+		}
+		if err := exists(periods, "budget rollover", ro.Id, "period", ro.ToPeriodId); err != nil {
+			return err
+		}
+	}
+
+	recurrings := make(map[int64]bool, len(doc.Recurrings))
+	for _, r := range doc.Recurrings {
+		if err := unique(recurrings, "recurring", r.Id); err != nil {
+			return err
+		}
+		if err := exists(accounts, "recurring", r.Id, "account", r.AccountId); err != nil {
+			return err
+		}
+		if err := optional(categories, "recurring", r.Id, "category", r.CategoryId); err != nil {
+			return err
+		}
+	}
+
+	actualized := make(map[int64]bool, len(doc.ActualizedRecurrings))
+	for _, ar := range doc.ActualizedRecurrings {
+		if err := unique(actualized, "actualized recurring", ar.Id); err != nil {
+			return err
+		}
+		if err := exists(accounts, "actualized recurring", ar.Id, "account", ar.AccountId); err != nil {
+			return err
+		}
+		if err := exists(periods, "actualized recurring", ar.Id, "period", ar.PeriodId); err != nil {
+			return err
+		}
+	}
+
+	profiles := make(map[int64]bool, len(doc.ImportProfiles))
+	for _, p := range doc.ImportProfiles {
+		if err := unique(profiles, "import profile", p.Id); err != nil {
+			return err
+		}
+		if err := exists(accounts, "import profile", p.Id, "account", p.AccountId); err != nil {
+			return err
+		}
+	}
+
+	batches := make(map[int64]bool, len(doc.ImportBatches))
+	for _, b := range doc.ImportBatches {
+		if err := unique(batches, "import batch", b.Id); err != nil {
+			return err
+		}
+		if err := exists(accounts, "import batch", b.Id, "account", b.AccountId); err != nil {
+			return err
+		}
+	}
+
+	transfers := make(map[int64]bool, len(doc.Transfers))
+	for _, t := range doc.Transfers {
+		if err := unique(transfers, "transfer", t.Id); err != nil {
+			return err
+		}
+		if err := exists(accounts, "transfer", t.Id, "account", t.FromAccountId); err != nil {
+			return err
+		}
+		if err := exists(accounts, "transfer", t.Id, "account", t.ToAccountId); err != nil {
+			return err
+		}
+	}
+
+	reconciliations := make(map[int64]bool, len(doc.Reconciliations))
+	for _, rec := range doc.Reconciliations {
+		if err := unique(reconciliations, "reconciliation", rec.Id); err != nil {
+			return err
+		}
+		if err := exists(accounts, "reconciliation", rec.Id, "account", rec.AccountId); err != nil {
+			return err
+		}
+	}
+
+	transactions := make(map[int64]bool, len(doc.Transactions))
+	for _, t := range doc.Transactions {
+		if err := unique(transactions, "transaction", t.Id); err != nil {
+			return err
+		}
+		if err := exists(accounts, "transaction", t.Id, "account", t.AccountId); err != nil {
+			return err
+		}
+		if err := exists(periods, "transaction", t.Id, "period", t.PeriodId); err != nil {
+			return err
+		}
+		if err := optional(categories, "transaction", t.Id, "category", t.CategoryId); err != nil {
+			return err
+		}
+		if err := optional(actualized, "transaction", t.Id, "actualized recurring", t.ActualizedRecurringId); err != nil {
+			return err
+		}
+		if err := optional(batches, "transaction", t.Id, "import batch", t.ImportBatchId); err != nil {
+			return err
+		}
+		if err := optional(transfers, "transaction", t.Id, "transfer", t.TransferId); err != nil {
+			return err
+		}
+		if err := optional(reconciliations, "transaction", t.Id, "reconciliation", t.ReconciliationId); err != nil {
+			return err
+		}
+	}
+
+	splits := make(map[int64]bool, len(doc.TransactionSplits))
+	for _, ts := range doc.TransactionSplits {
+		if err := unique(splits, "transaction split", ts.Id); err != nil {
+			return err
+		}
+		if err := exists(transactions, "transaction split", ts.Id, "transaction", ts.TransactionId); err != nil {
+			return err
+		}
+		if err := optional(categories, "transaction split", ts.Id, "category", ts.CategoryId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func unique(ids map[int64]bool, table string, id int64) error {
+	if ids[id] {
+		return fmt.Errorf("%w: duplicate %s id %d", ErrorIntegrity, table, id)
+	}
+	ids[id] = true
+	return nil
+}
+
+func exists(ids map[int64]bool, table string, id int64, refTable string, refId int64) error {
+	if !ids[refId] {
+		return fmt.Errorf("%w: %s %d references missing %s %d", ErrorIntegrity, table, id, refTable, refId)
+	}
+	return nil
+}
+
+func optional(ids map[int64]bool, table string, id int64, refTable string, refId int64) error {
+	if refId == 0 {
+		return nil
+	}
+	return exists(ids, table, id, refTable, refId)
+}
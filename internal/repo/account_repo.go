@@ -9,10 +9,10 @@ import (
 )
 
 type AccountRepo struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewAccountRepo(db *sql.DB) *AccountRepo {
+func NewAccountRepo(db DBTX) *AccountRepo {
 	return &AccountRepo{db: db}
 }
 
@@ -0,0 +1,198 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+type BudgetRepo struct {
+	db DBTX
+}
+
+func NewBudgetRepo(db DBTX) *BudgetRepo {
+	return &BudgetRepo{db: db}
+}
+
+const QSetBudget = `
+	insert into budgets (account_id, period_id, category_id, amount)
+	values (@account_id, @period_id, @category_id, @amount)
+	on conflict(period_id, category_id) do update set amount = excluded.amount
+	returning id, account_id, period_id, category_id, amount
+`
+
+// Set saves the category's budget for the period, replacing any existing one.
+func (r *BudgetRepo) Set(ctx context.Context, b domain.Budget) (domain.Budget, error) {
+	row := r.db.QueryRowContext(ctx, QSetBudget,
+		sql.Named("account_id", b.AccountId),
+		sql.Named("period_id", b.PeriodId),
+		sql.Named("category_id", b.CategoryId),
+		sql.Named("amount", b.Amount),
+	)
+
+	err := row.Scan(&b.Id, &b.AccountId, &b.PeriodId, &b.CategoryId, &b.Amount)
+	if err != nil {
+		return b, fmt.Errorf("set budget: %w", err)
+	}
+	return b, nil
+}
+
+const QCopyBudgets = `
+	insert into budgets (account_id, period_id, category_id, amount)
+	select account_id, @to_period_id, category_id, amount
+	from budgets
+	where period_id = @from_period_id
+	on conflict(period_id, category_id) do update set amount = excluded.amount
+`
+
+// Copy sets every budget of one period on another, overwriting budgets the
+// target period already has for the same categories.
+func (r *BudgetRepo) Copy(ctx context.Context, fromPeriodId int64, toPeriodId int64) error {
+	_, err := r.db.ExecContext(ctx, QCopyBudgets,
+		sql.Named("from_period_id", fromPeriodId),
+		sql.Named("to_period_id", toPeriodId),
+	)
+	if err != nil {
+		return fmt.Errorf("copy budgets from period %d to %d: %w", fromPeriodId, toPeriodId, err)
+	}
+	return nil
+}
+
+const QBudgetVsActual = `
+with ` + categoryLines + `,
+actual as (
+	select category_id, sum(amount) amount
+	from lines
+	group by category_id
+)
+select c.id
+     , c.account_id
+     , c.name
+     , c.color
+     , c.rollover
+     , coalesce(b.amount, 0)
+     , coalesce(r.amount, 0)
+     , -coalesce(a.amount, 0)
+from categories c
+left join budgets b on b.category_id = c.id and b.period_id = @period_id
+left join budget_rollovers r on r.category_id = c.id and r.to_period_id = @period_id
+left join actual a on a.category_id = c.id
+where c.account_id = @account_id
+order by c.name collate nocase
+`
+
+// BudgetVsActual lists every category of the account with its budget,
+// rolled over amount and spending for the period. Split transactions count
+// toward each line's category.
+func (r *BudgetRepo) BudgetVsActual(ctx context.Context, accountId int64, periodId int64) ([]domain.BudgetLine, error) {
+	rows, err := r.db.QueryContext(ctx, QBudgetVsActual,
+		sql.Named("account_id", accountId),
+		sql.Named("period_id", periodId),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query budget vs actual: %w", err)
+	}
+	defer rows.Close()
+
+	lines := make([]domain.BudgetLine, 0, 10)
+	for rows.Next() {
+		var l domain.BudgetLine
+		err := rows.Scan(&l.Id, &l.AccountId, &l.Name, &l.Color, &l.Rollover, &l.Budgeted, &l.CarriedIn, &l.Spent)
+		if err != nil {
+			return lines, fmt.Errorf("scan budget vs actual: %w", err)
+		}
+
+		l.Available = l.Budgeted + l.CarriedIn
+		l.Remaining = l.Available - l.Spent
+		if l.Available > 0 {
+			l.Percent = float64(l.Spent) / float64(l.Available) * 100
+		}
+		lines = append(lines, l)
+	}
+
+	return lines, rows.Err()
+}
+
+const QAddRollover = `
+	insert into budget_rollovers (account_id, category_id, from_period_id, to_period_id, amount, timestamp_created)
+	values (@account_id, @category_id, @from_period_id, @to_period_id, @amount, @timestamp_created)
+	on conflict(to_period_id, category_id) do update set
+		from_period_id = excluded.from_period_id,
+		amount = excluded.amount
+`
+
+// RollOver carries what is left in each rollover category of one period into
+// the next. Deficits carry as negative amounts and categories that came out
+// even are skipped. The carried amount is a snapshot taken as the period
+// closes; later edits to the closed period are not carried forward, which is
+// why imports refuse rows dated in a closed period.
+func (r *BudgetRepo) RollOver(ctx context.Context, accountId int64, fromPeriodId int64, toPeriodId int64) error {
+	lines, err := r.BudgetVsActual(ctx, accountId, fromPeriodId)
+	if err != nil {
+		return fmt.Errorf("roll over budgets: %w", err)
+	}
+
+	now := time.Now().UnixMilli()
+	for _, l := range lines {
+		if !l.Rollover || l.Remaining == 0 {
+			continue
+		}
+
+		_, err := r.db.ExecContext(ctx, QAddRollover,
+			sql.Named("account_id", accountId),
+			sql.Named("category_id", l.Id),
+			sql.Named("from_period_id", fromPeriodId),
+			sql.Named("to_period_id", toPeriodId),
+			sql.Named("amount", l.Remaining),
+			sql.Named("timestamp_created", now),
+		)
+		if err != nil {
+			return fmt.Errorf("roll over category %d into period %d: %w", l.Id, toPeriodId, err)
+		}
+	}
+
+	return nil
+}
+
+const QRolloverHistory = `
+select r.id
+     , r.account_id
+     , r.category_id
+     , r.from_period_id
+     , r.to_period_id
+     , coalesce(p.reporting_start_timestamp, 0)
+     , r.amount
+     , r.timestamp_created
+from budget_rollovers r
+left join periods p on p.id = r.to_period_id
+where r.category_id = @category_id
+order by p.reporting_start_timestamp desc, r.id desc
+`
+
+// RolloverHistory lists what the category carried into each period, newest
+// first.
+func (r *BudgetRepo) RolloverHistory(ctx context.Context, categoryId int64) ([]domain.BudgetRollover, error) {
+	rows, err := r.db.QueryContext(ctx, QRolloverHistory, sql.Named("category_id", categoryId))
+	if err != nil {
+		return nil, fmt.Errorf("query rollover history for category %d: %w", categoryId, err)
+	}
+	defer rows.Close()
+
+	history := make([]domain.BudgetRollover, 0, 10)
+	for rows.Next() {
+		var h domain.BudgetRollover
+		var periodStart, created int64
+		err := rows.Scan(&h.Id, &h.AccountId, &h.CategoryId, &h.FromPeriodId, &h.ToPeriodId, &periodStart, &h.Amount, &created)
+		if err != nil {
+			return history, fmt.Errorf("scan rollover history: %w", err)
+		}
+
+		h.PeriodStart = time.UnixMilli(periodStart)
+		h.CreatedOn = time.UnixMilli(created)
+		history = append(history, h)
+	}
+
+	return history, rows.Err()
+}
@@ -9,15 +9,15 @@ import (
 )
 
 type CategoryRepo struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewCategoryRepo(sb *sql.DB) *CategoryRepo {
+func NewCategoryRepo(sb DBTX) *CategoryRepo {
 	return &CategoryRepo{db: sb}
 }
 
 const QListCategories = `
-	select id, name, color from categories
+	select id, account_id, name, color, rollover, kind from categories
 	where account_id = @account_id
 `
 
@@ -37,7 +37,7 @@ func (r *CategoryRepo) List(ctx context.Context, accountId int64) ([]domain.Cate
 	var cat domain.Category
 	list := make([]domain.Category, 0, 10)
 	for rows.Next() {
-		err = rows.Scan(&cat.Id, &cat.Name, &cat.Color)
+		err = rows.Scan(&cat.Id, &cat.AccountId, &cat.Name, &cat.Color, &cat.Rollover, &cat.Kind)
 		if err != nil {
 			return list, fmt.Errorf("scan list categories: %w", err)
 		}
@@ -49,7 +49,7 @@ func (r *CategoryRepo) List(ctx context.Context, accountId int64) ([]domain.Cate
 }
 
 const QSingleCategory = `
-select id, account_id, name, color 
+select id, account_id, name, color, rollover, kind
 from categories
 where id = @id
 `
@@ -58,7 +58,7 @@ func (r *CategoryRepo) Single(ctx context.Context, categoryId int64) (domain.Cat
 	var c domain.Category
 	row := r.db.QueryRowContext(ctx, QSingleCategory, sql.Named("id", categoryId))
 
-	err := row.Scan(&c.Id, &c.AccountId, &c.Name, &c.Color)
+	err := row.Scan(&c.Id, &c.AccountId, &c.Name, &c.Color, &c.Rollover, &c.Kind)
 	if err != nil {
 		return c, fmt.Errorf("scan single category: %w", err)
 	}
@@ -66,10 +66,64 @@ func (r *CategoryRepo) Single(ctx context.Context, categoryId int64) (domain.Cat
 	return c, nil
 }
 
+// categoryLines is a cte of (category_id, amount) for the period's
+// transactions with each split as its own line. Opening balances and
+// transfers are left out since they are not income or spending.
+const categoryLines = `
+lines as (
+	select coalesce(s.category_id, t.category_id) category_id
+	     , coalesce(s.amount, t.amount) amount
+	from transactions t
+	left join transaction_splits s on s.transaction_id = t.id
+	where t.account_id = @account_id
+	  and t.period_id = @period_id
+	  and t.can_delete = true
+	  and t.transfer_id is null
+)`
+
+const QCategoryTotals = `
+with ` + categoryLines + `
+select coalesce(c.id, 0)
+     , coalesce(c.name, 'Uncategorized')
+     , coalesce(c.color, '')
+     , sum(l.amount)
+     , count(1)
+from lines l
+left join categories c on c.id = l.category_id
+group by coalesce(c.id, 0)
+order by sum(l.amount)
+`
+
+// Totals sums the period's transactions by category, counting each split
+// toward its own category. Opening balances and transfers are left out.
+func (r *CategoryRepo) Totals(ctx context.Context, accountId int64, periodId int64) ([]domain.CategoryTotal, error) {
+	rows, err := r.db.QueryContext(ctx, QCategoryTotals,
+		sql.Named("account_id", accountId),
+		sql.Named("period_id", periodId),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query category totals: %w", err)
+	}
+	defer rows.Close()
+
+	totals := make([]domain.CategoryTotal, 0, 10)
+	for rows.Next() {
+		var t domain.CategoryTotal
+		err := rows.Scan(&t.Id, &t.Name, &t.Color, &t.Amount, &t.Count)
+		if err != nil {
+			return totals, fmt.Errorf("scan category totals: %w", err)
+		}
+		t.AccountId = accountId
+		totals = append(totals, t)
+	}
+
+	return totals, nil
+}
+
 const QInsertCategory = `
-insert into categories (name, account_id, color)
-values (@name, @account_id, @color)
-returning id, name, account_id, color
+insert into categories (name, account_id, color, rollover, kind)
+values (@name, @account_id, @color, @rollover, coalesce(nullif(@kind, ''), 'auto'))
+returning id, name, account_id, color, rollover, kind
 `
 
 func (r *CategoryRepo) Add(ctx context.Context, c domain.Category) (domain.Category, error) {
@@ -77,9 +131,11 @@ func (r *CategoryRepo) Add(ctx context.Context, c domain.Category) (domain.Categ
 		sql.Named("name", c.Name),
 		sql.Named("account_id", c.AccountId),
 		sql.Named("color", c.Color),
+		sql.Named("rollover", c.Rollover),
+		sql.Named("kind", c.Kind),
 	)
 
-	err := row.Scan(&c.Id, &c.Name, &c.AccountId, &c.Color)
+	err := row.Scan(&c.Id, &c.Name, &c.AccountId, &c.Color, &c.Rollover, &c.Kind)
 	if err != nil {
 		return c, fmt.Errorf("add category: %w", err)
 	}
@@ -91,19 +147,26 @@ const QUpdateCategory = `
 update categories 
 set name = @name, 
 	account_id = @account_id, 
-	color = @color
+	color = @color,
+	rollover = @rollover,
+	kind = coalesce(nullif(@kind, ''), 'auto')
 where id = @id
-returning id, name, account_id, color
+returning id, name, account_id, color, rollover, kind
 `
 
 func (r *CategoryRepo) Update(ctx context.Context, c domain.Category) (domain.Category, error) {
 	row := r.db.QueryRowContext(ctx, QUpdateCategory,
 		sql.Named("id", c.Id),
+		sql.Named("name", c.Name),
+		sql.Named("account_id", c.AccountId),
+		sql.Named("color", c.Color),
+		sql.Named("rollover", c.Rollover),
+		sql.Named("kind", c.Kind),
 	)
 
-	err := row.Scan(&c.Id, &c.Name, &c.AccountId, &c.Color)
+	err := row.Scan(&c.Id, &c.Name, &c.AccountId, &c.Color, &c.Rollover, &c.Kind)
 	if err != nil {
-		return c, fmt.Errorf("add category: %w", err)
+		return c, fmt.Errorf("update category: %w", err)
 	}
 
 	return c, nil
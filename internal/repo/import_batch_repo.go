@@ -0,0 +1,41 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+type ImportBatchRepo struct {
+	db DBTX
+}
+
+func NewImportBatchRepo(db DBTX) *ImportBatchRepo {
+	return &ImportBatchRepo{db: db}
+}
+
+const QInsertImportBatch = `
+	insert into import_batches (account_id, source, file_name, timestamp_imported)
+	values (@account_id, @source, @file_name, @timestamp_imported)
+	returning id, account_id, source, file_name, timestamp_imported
+`
+
+func (r *ImportBatchRepo) Add(ctx context.Context, b domain.ImportBatch) (domain.ImportBatch, error) {
+	row := r.db.QueryRowContext(ctx, QInsertImportBatch,
+		sql.Named("account_id", b.AccountId),
+		sql.Named("source", b.Source),
+		sql.Named("file_name", b.FileName),
+		sql.Named("timestamp_imported", time.Now().UnixMilli()),
+	)
+
+	var importedMillis int64
+	err := row.Scan(&b.Id, &b.AccountId, &b.Source, &b.FileName, &importedMillis)
+	if err != nil {
+		return b, fmt.Errorf("scan add import batch: %w", err)
+	}
+
+	b.ImportedOn = time.UnixMilli(importedMillis).UTC()
+	return b, nil
+}
@@ -0,0 +1,201 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+type ImportProfileRepo struct {
+	db DBTX
+}
+
+func NewImportProfileRepo(db DBTX) *ImportProfileRepo {
+	return &ImportProfileRepo{db: db}
+}
+
+const QListImportProfiles = `
+select p.id
+     , p.account_id
+     , p.name
+     , p.delimiter
+     , p.date_format
+     , p.header_rows
+     , p.date_column
+     , p.name_column
+     , p.amount_column
+     , p.debit_column
+     , p.credit_column
+     , p.negate_amounts
+from import_profiles p
+where p.account_id = @account_id
+order by p.name
+`
+
+func (r *ImportProfileRepo) List(ctx context.Context, accountId int64) ([]domain.ImportProfile, error) {
+	rows, err := r.db.QueryContext(ctx, QListImportProfiles, sql.Named("account_id", accountId))
+	if err != nil {
+		return nil, fmt.Errorf("query list import profiles: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]domain.ImportProfile, 0, 5)
+	for rows.Next() {
+		p, err := scanImportProfile(rows)
+		if err != nil {
+			return results, fmt.Errorf("scan list import profiles: %w", err)
+		}
+		results = append(results, p)
+	}
+
+	return results, nil
+}
+
+const QSingleImportProfile = `
+select p.id
+     , p.account_id
+     , p.name
+     , p.delimiter
+     , p.date_format
+     , p.header_rows
+     , p.date_column
+     , p.name_column
+     , p.amount_column
+     , p.debit_column
+     , p.credit_column
+     , p.negate_amounts
+from import_profiles p
+where p.id = @id
+`
+
+func (r *ImportProfileRepo) Single(ctx context.Context, id int64) (domain.ImportProfile, error) {
+	row := r.db.QueryRowContext(ctx, QSingleImportProfile, sql.Named("id", id))
+	p, err := scanImportProfile(row)
+	if err != nil {
+		return p, fmt.Errorf("single import profile %d: %w", id, err)
+	}
+	return p, nil
+}
+
+const QInsertImportProfile = `
+insert into import_profiles (
+	  account_id
+	, name
+	, delimiter
+	, date_format
+	, header_rows
+	, date_column
+	, name_column
+	, amount_column
+	, debit_column
+	, credit_column
+	, negate_amounts)
+values (
+	@account_id,
+	@name,
+	@delimiter,
+	@date_format,
+	@header_rows,
+	@date_column,
+	@name_column,
+	@amount_column,
+	@debit_column,
+	@credit_column,
+	@negate_amounts)
+returning id, account_id, name, delimiter, date_format, header_rows, date_column, name_column, amount_column, debit_column, credit_column, negate_amounts
+`
+
+func (r *ImportProfileRepo) Add(ctx context.Context, p domain.ImportProfile) (domain.ImportProfile, error) {
+	row := r.db.QueryRowContext(ctx, QInsertImportProfile,
+		sql.Named("account_id", p.AccountId),
+		sql.Named("name", p.Name),
+		sql.Named("delimiter", p.Delimiter),
+		sql.Named("date_format", p.DateFormat),
+		sql.Named("header_rows", p.HeaderRows),
+		sql.Named("date_column", p.DateColumn),
+		sql.Named("name_column", p.NameColumn),
+		sql.Named("amount_column", p.AmountColumn),
+		sql.Named("debit_column", p.DebitColumn),
+		sql.Named("credit_column", p.CreditColumn),
+		sql.Named("negate_amounts", p.NegateAmounts),
+	)
+
+	p, err := scanImportProfile(row)
+	if err != nil {
+		return p, fmt.Errorf("add import profile: %w", err)
+	}
+	return p, nil
+}
+
+const QUpdateImportProfile = `
+update import_profiles
+set name           = @name,
+    delimiter      = @delimiter,
+    date_format    = @date_format,
+    header_rows    = @header_rows,
+    date_column    = @date_column,
+    name_column    = @name_column,
+    amount_column  = @amount_column,
+    debit_column   = @debit_column,
+    credit_column  = @credit_column,
+    negate_amounts = @negate_amounts
+where id = @id
+returning id, account_id, name, delimiter, date_format, header_rows, date_column, name_column, amount_column, debit_column, credit_column, negate_amounts
+`
+
+func (r *ImportProfileRepo) Update(ctx context.Context, p domain.ImportProfile) (domain.ImportProfile, error) {
+	row := r.db.QueryRowContext(ctx, QUpdateImportProfile,
+		sql.Named("id", p.Id),
+		sql.Named("name", p.Name),
+		sql.Named("delimiter", p.Delimiter),
+		sql.Named("date_format", p.DateFormat),
+		sql.Named("header_rows", p.HeaderRows),
+		sql.Named("date_column", p.DateColumn),
+		sql.Named("name_column", p.NameColumn),
+		sql.Named("amount_column", p.AmountColumn),
+		sql.Named("debit_column", p.DebitColumn),
+		sql.Named("credit_column", p.CreditColumn),
+		sql.Named("negate_amounts", p.NegateAmounts),
+	)
+
+	out, err := scanImportProfile(row)
+	if err != nil {
+		return p, fmt.Errorf("update import profile %d: %w", p.Id, err)
+	}
+	return out, nil
+}
+
+const QDeleteImportProfile = `
+	delete from import_profiles where id = @id
+`
+
+func (r *ImportProfileRepo) Delete(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, QDeleteImportProfile, sql.Named("id", id))
+	if err != nil {
+		return fmt.Errorf("exec delete import profile %d: %w", id, err)
+	}
+	return nil
+}
+
+func scanImportProfile(row interface{ Scan(dest ...any) error }) (domain.ImportProfile, error) {
+	var p domain.ImportProfile
+	err := row.Scan(
+		&p.Id,
+		&p.AccountId,
+		&p.Name,
+		&p.Delimiter,
+		&p.DateFormat,
+		&p.HeaderRows,
+		&p.DateColumn,
+		&p.NameColumn,
+		&p.AmountColumn,
+		&p.DebitColumn,
+		&p.CreditColumn,
+		&p.NegateAmounts,
+	)
+	if err != nil {
+		return p, fmt.Errorf("scan import profile: %w", err)
+	}
+	return p, nil
+}
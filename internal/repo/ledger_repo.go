@@ -0,0 +1,654 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"tjdickerson/sacbooks/internal/domain"
+	"tjdickerson/sacbooks/internal/ledger"
+)
+
+// LedgerRepo reads and writes whole tables with their original ids for the
+// JSON ledger export and import.
+type LedgerRepo struct {
+	db DBTX
+}
+
+func NewLedgerRepo(db DBTX) *LedgerRepo {
+	return &LedgerRepo{db: db}
+}
+
+const QLedgerAccounts = `
+	select id, name, period_start_day, can_delete from accounts order by id
+`
+
+const QLedgerPeriods = `
+	select id, account_id, reporting_start_timestamp, reporting_end_timestamp, opened_on_timestamp, closed_on_timestamp
+	from periods order by id
+`
+
+const QLedgerCategories = `
+	select id, account_id, name, color, rollover, kind from categories order by id
+`
+
+const QLedgerBudgets = `
+	select id, account_id, period_id, category_id, amount from budgets order by id
+`
+
+const QLedgerBudgetRollovers = `
+	select id, account_id, category_id, from_period_id, to_period_id, amount, timestamp_created
+	from budget_rollovers order by id
+`
+
+const QLedgerRecurrings = `
+	select id, account_id, category_id, name, occurrence_day, amount, frequency, interval_count,
+	       anchor_timestamp, end_timestamp, occurrence_count, weekday, auto_apply, timestamp_added
+	from recurrings order by id
+`
+
+const QLedgerActualizedRecurrings = `
+	select id, account_id, period_id, coalesce(based_on_id, 0), coalesce(category_snapshot, ''),
+	       name_snapshot, occurrence_day_snapshot, amount_snapshot, actual_amount, occurrence_timestamp, timestamp_created
+	from actualized_recurrings order by id
+`
+
+const QLedgerImportProfiles = `
+	select id, account_id, name, delimiter, date_format, header_rows, date_column, name_column,
+	       amount_column, debit_column, credit_column, negate_amounts
+	from import_profiles order by id
+`
+
+const QLedgerImportBatches = `
+	select id, account_id, source, file_name, timestamp_imported from import_batches order by id
+`
+
+const QLedgerTransactions = `
+	select id, account_id, period_id, coalesce(category_id, 0), coalesce(actualized_recurring_id, 0),
+	       coalesce(import_batch_id, 0), transaction_date, amount, name, coalesce(notes, ''),
+	       coalesce(external_id, ''), coalesce(transfer_id, 0), coalesce(reconciliation_id, 0), status,
+	       can_delete, timestamp_added
+	from transactions order by id
+`
+
+const QLedgerReconciliations = `
+	select id, account_id, statement_date, statement_balance, timestamp_started, timestamp_finalized
+	from reconciliations order by id
+`
+
+const QLedgerTransfers = `
+	select id, from_account_id, to_account_id, timestamp_created from transfers order by id
+`
+
+const QLedgerTransactionSplits = `
+	select id, transaction_id, category_id, amount, coalesce(memo, '')
+	from transaction_splits order by id
+`
+
+const QLedgerSchemaVersion = `
+	select coalesce(max(version), 0) from schema_version
+`
+
+// Dump reads every table into a document. Run it inside a unit of work for a
+// consistent snapshot.
+func (r *LedgerRepo) Dump(ctx context.Context) (ledger.Document, error) {
+	doc := ledger.Document{
+		Accounts:             []ledger.Account{},
+		Periods:              []ledger.Period{},
+		Categories:           []ledger.Category{},
+		Budgets:              []ledger.Budget{},
+		BudgetRollovers:      []ledger.BudgetRollover{},
+		Recurrings:           []ledger.Recurring{},
+		ActualizedRecurrings: []ledger.ActualizedRecurring{},
+		ImportProfiles:       []ledger.ImportProfile{},
+		ImportBatches:        []ledger.ImportBatch{},
+		Transfers:            []ledger.Transfer{},
+		Reconciliations:      []ledger.Reconciliation{},
+		Transactions:         []ledger.Transaction{},
+		TransactionSplits:    []ledger.TransactionSplit{},
+	}
+
+	err := r.db.QueryRowContext(ctx, QLedgerSchemaVersion).Scan(&doc.SchemaVersion)
+	if err != nil {
+		return doc, fmt.Errorf("dump schema version: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerAccounts, func(rows *sql.Rows) error {
+		var a ledger.Account
+		err := rows.Scan(&a.Id, &a.Name, &a.PeriodStartDay, &a.CanDelete)
+		doc.Accounts = append(doc.Accounts, a)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump accounts: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerPeriods, func(rows *sql.Rows) error {
+		var p ledger.Period
+		var closed sql.NullInt64
+		err := rows.Scan(&p.Id, &p.AccountId, &p.ReportingStart, &p.ReportingEnd, &p.OpenedOn, &closed)
+		if closed.Valid {
+			p.ClosedOn = &closed.Int64
+		}
+		doc.Periods = append(doc.Periods, p)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump periods: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerCategories, func(rows *sql.Rows) error {
+		var c ledger.Category
+		err := rows.Scan(&c.Id, &c.AccountId, &c.Name, &c.Color, &c.Rollover, &c.Kind)
+		doc.Categories = append(doc.Categories, c)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump categories: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerBudgets, func(rows *sql.Rows) error {
+		var b ledger.Budget
+		err := rows.Scan(&b.Id, &b.AccountId, &b.PeriodId, &b.CategoryId, &b.Amount)
+		doc.Budgets = append(doc.Budgets, b)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump budgets: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerBudgetRollovers, func(rows *sql.Rows) error {
+		var ro ledger.BudgetRollover
+		err := rows.Scan(&ro.Id, &ro.AccountId, &ro.CategoryId, &ro.FromPeriodId, &ro.ToPeriodId, &ro.Amount, &ro.CreatedOn)
+		doc.BudgetRollovers = append(doc.BudgetRollovers, ro)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump budget rollovers: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerRecurrings, func(rows *sql.Rows) error {
+		var rt ledger.Recurring
+		var anchor, end sql.NullInt64
+		err := rows.Scan(&rt.Id, &rt.AccountId, &rt.CategoryId, &rt.Name, &rt.Day, &rt.Amount, &rt.Frequency,
+			&rt.Interval, &anchor, &end, &rt.Count, &rt.Weekday, &rt.AutoApply, &rt.AddedOn)
+		rt.Anchor = int64Pointer(anchor)
+		rt.End = int64Pointer(end)
+		doc.Recurrings = append(doc.Recurrings, rt)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump recurrings: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerActualizedRecurrings, func(rows *sql.Rows) error {
+		var ar ledger.ActualizedRecurring
+		var actual, occurrence sql.NullInt64
+		err := rows.Scan(&ar.Id, &ar.AccountId, &ar.PeriodId, &ar.BasedOnId, &ar.CategorySnapshot,
+			&ar.NameSnapshot, &ar.DaySnapshot, &ar.AmountSnapshot, &actual, &occurrence, &ar.CreatedOn)
+		ar.ActualAmount = int64Pointer(actual)
+		ar.Occurrence = int64Pointer(occurrence)
+		doc.ActualizedRecurrings = append(doc.ActualizedRecurrings, ar)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump actualized recurrings: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerImportProfiles, func(rows *sql.Rows) error {
+		var p ledger.ImportProfile
+		err := rows.Scan(&p.Id, &p.AccountId, &p.Name, &p.Delimiter, &p.DateFormat, &p.HeaderRows, &p.DateColumn,
+			&p.NameColumn, &p.AmountColumn, &p.DebitColumn, &p.CreditColumn, &p.NegateAmounts)
+		doc.ImportProfiles = append(doc.ImportProfiles, p)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump import profiles: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerImportBatches, func(rows *sql.Rows) error {
+		var b ledger.ImportBatch
+		err := rows.Scan(&b.Id, &b.AccountId, &b.Source, &b.FileName, &b.ImportedOn)
+		doc.ImportBatches = append(doc.ImportBatches, b)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump import batches: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerTransfers, func(rows *sql.Rows) error {
+		var t ledger.Transfer
+		err := rows.Scan(&t.Id, &t.FromAccountId, &t.ToAccountId, &t.CreatedOn)
+		doc.Transfers = append(doc.Transfers, t)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump transfers: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerReconciliations, func(rows *sql.Rows) error {
+		var rec ledger.Reconciliation
+		var finalized sql.NullInt64
+		err := rows.Scan(&rec.Id, &rec.AccountId, &rec.StatementDate, &rec.StatementBalance, &rec.StartedOn, &finalized)
+		if finalized.Valid {
+			rec.FinalizedOn = &finalized.Int64
+		}
+		doc.Reconciliations = append(doc.Reconciliations, rec)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump reconciliations: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerTransactions, func(rows *sql.Rows) error {
+		var t ledger.Transaction
+		err := rows.Scan(&t.Id, &t.AccountId, &t.PeriodId, &t.CategoryId, &t.ActualizedRecurringId,
+			&t.ImportBatchId, &t.Date, &t.Amount, &t.Name, &t.Notes, &t.ExternalId, &t.TransferId, &t.ReconciliationId, &t.Status,
+			&t.CanDelete, &t.AddedOn)
+		doc.Transactions = append(doc.Transactions, t)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump transactions: %w", err)
+	}
+
+	err = queryEach(ctx, r.db, QLedgerTransactionSplits, func(rows *sql.Rows) error {
+		var ts ledger.TransactionSplit
+		err := rows.Scan(&ts.Id, &ts.TransactionId, &ts.CategoryId, &ts.Amount, &ts.Memo)
+		doc.TransactionSplits = append(doc.TransactionSplits, ts)
+		return err
+	})
+	if err != nil {
+		return doc, fmt.Errorf("dump transaction splits: %w", err)
+	}
+
+	return doc, nil
+}
+
+const QLedgerHasUserData = `
+select (select count(1) from accounts) > 1
+    or (select count(1) from categories) > 1
+    or (select count(1) from transactions where can_delete = true or amount != 0) > 0
+    or (select count(1) from recurrings) > 0
+    or (select count(1) from actualized_recurrings) > 0
+    or (select count(1) from import_profiles) > 0
+    or (select count(1) from import_batches) > 0
+    or (select count(1) from transfers) > 0
+    or (select count(1) from reconciliations) > 0
+    or (select count(1) from budgets) > 0
+    or (select count(1) from budget_rollovers) > 0
+`
+
+// IsPristine reports whether the database holds nothing but the default
+// account, category and opening balance created the first time the app starts.
+func (r *LedgerRepo) IsPristine(ctx context.Context) (bool, error) {
+	var hasData bool
+	err := r.db.QueryRowContext(ctx, QLedgerHasUserData).Scan(&hasData)
+	if err != nil {
+		return false, fmt.Errorf("scan ledger has user data: %w", err)
+	}
+	return !hasData, nil
+}
+
+// clearOrder deletes children before the rows they reference.
+var clearOrder = []string{
+	"transaction_splits",
+	"transactions",
+	"transfers",
+	"reconciliations",
+	"import_batches",
+	"import_profiles",
+	"actualized_recurrings",
+	"recurrings",
+	"budget_rollovers",
+	"budgets",
+	"categories",
+	"periods",
+	"accounts",
+}
+
+// Clear deletes every row from the ledger tables.
+func (r *LedgerRepo) Clear(ctx context.Context) error {
+	for _, table := range clearOrder {
+		_, err := r.db.ExecContext(ctx, "delete from "+table)
+		if err != nil {
+			return fmt.Errorf("clear %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+const QLedgerInsertAccount = `
+	insert into accounts (id, name, period_start_day, can_delete)
+	values (@id, @name, @period_start_day, @can_delete)
+`
+
+const QLedgerInsertPeriod = `
+	insert into periods (id, account_id, reporting_start_timestamp, reporting_end_timestamp, opened_on_timestamp, closed_on_timestamp)
+	values (@id, @account_id, @reporting_start, @reporting_end, @opened_on, @closed_on)
+`
+
+const QLedgerInsertCategory = `
+	insert into categories (id, account_id, name, color, rollover, kind)
+	values (@id, @account_id, @name, @color, @rollover, coalesce(nullif(@kind, ''), 'auto'))
+`
+
+const QLedgerInsertBudget = `
+	insert into budgets (id, account_id, period_id, category_id, amount)
+	values (@id, @account_id, @period_id, @category_id, @amount)
+`
+
+const QLedgerInsertBudgetRollover = `
+	insert into budget_rollovers (id, account_id, category_id, from_period_id, to_period_id, amount, timestamp_created)
+	values (@id, @account_id, @category_id, @from_period_id, @to_period_id, @amount, @timestamp_created)
+`
+
+const QLedgerInsertRecurring = `
+	insert into recurrings (id, account_id, category_id, name, occurrence_day, amount, frequency, interval_count,
+	                        anchor_timestamp, end_timestamp, occurrence_count, weekday, auto_apply, timestamp_added)
+	values (@id, @account_id, @category_id, @name, @occurrence_day, @amount,
+	        coalesce(nullif(@frequency, ''), 'monthly'), max(@interval_count, 1),
+	        @anchor_timestamp, @end_timestamp, @occurrence_count, @weekday, @auto_apply, @timestamp_added)
+`
+
+const QLedgerInsertActualizedRecurring = `
+	insert into actualized_recurrings (id, account_id, period_id, based_on_id, category_snapshot, name_snapshot,
+	                                   occurrence_day_snapshot, amount_snapshot, actual_amount, occurrence_timestamp,
+	                                   timestamp_created)
+	values (@id, @account_id, @period_id, @based_on_id, @category_snapshot, @name_snapshot,
+	        @occurrence_day_snapshot, @amount_snapshot, coalesce(@actual_amount, @amount_snapshot), @occurrence_timestamp,
+	        @timestamp_created)
+`
+
+const QLedgerInsertImportProfile = `
+	insert into import_profiles (id, account_id, name, delimiter, date_format, header_rows, date_column, name_column,
+	                             amount_column, debit_column, credit_column, negate_amounts)
+	values (@id, @account_id, @name, @delimiter, @date_format, @header_rows, @date_column, @name_column,
+	        @amount_column, @debit_column, @credit_column, @negate_amounts)
+`
+
+const QLedgerInsertImportBatch = `
+	insert into import_batches (id, account_id, source, file_name, timestamp_imported)
+	values (@id, @account_id, @source, @file_name, @timestamp_imported)
+`
+
+const QLedgerInsertTransaction = `
+	insert into transactions (id, account_id, period_id, category_id, actualized_recurring_id, import_batch_id,
+	                          transaction_date, amount, name, notes, external_id, transfer_id, reconciliation_id, status,
+	                          can_delete, timestamp_added)
+	values (@id, @account_id, @period_id, @category_id, @actualized_recurring_id, @import_batch_id,
+	        @transaction_date, @amount, @name, @notes, @external_id, @transfer_id, @reconciliation_id, @status,
+	        @can_delete, @timestamp_added)
+`
+
+const QLedgerInsertReconciliation = `
+	insert into reconciliations (id, account_id, statement_date, statement_balance, timestamp_started, timestamp_finalized)
+	values (@id, @account_id, @statement_date, @statement_balance, @timestamp_started, @timestamp_finalized)
+`
+
+const QLedgerInsertTransfer = `
+	insert into transfers (id, from_account_id, to_account_id, timestamp_created)
+	values (@id, @from_account_id, @to_account_id, @timestamp_created)
+`
+
+const QLedgerInsertTransactionSplit = `
+	insert into transaction_splits (id, transaction_id, category_id, amount, memo)
+	values (@id, @transaction_id, @category_id, @amount, @memo)
+`
+
+// Restore inserts every row of the document keeping its id. The tables are
+// expected to be empty.
+func (r *LedgerRepo) Restore(ctx context.Context, doc ledger.Document) error {
+	for _, a := range doc.Accounts {
+		_, err := r.db.ExecContext(ctx, QLedgerInsertAccount,
+			sql.Named("id", a.Id),
+			sql.Named("name", a.Name),
+			sql.Named("period_start_day", a.PeriodStartDay),
+			sql.Named("can_delete", a.CanDelete),
+		)
+		if err != nil {
+			return fmt.Errorf("restore account %d: %w", a.Id, err)
+		}
+	}
+
+	for _, p := range doc.Periods {
+		closed := sql.NullInt64{}
+		if p.ClosedOn != nil {
+			closed = sql.NullInt64{Int64: *p.ClosedOn, Valid: true}
+		}
+		_, err := r.db.ExecContext(ctx, QLedgerInsertPeriod,
+			sql.Named("id", p.Id),
+			sql.Named("account_id", p.AccountId),
+			sql.Named("reporting_start", p.ReportingStart),
+			sql.Named("reporting_end", p.ReportingEnd),
+			sql.Named("opened_on", p.OpenedOn),
+			sql.Named("closed_on", closed),
+		)
+		if err != nil {
+			return fmt.Errorf("restore period %d: %w", p.Id, err)
+		}
+	}
+
+	for _, c := range doc.Categories {
+		_, err := r.db.ExecContext(ctx, QLedgerInsertCategory,
+			sql.Named("id", c.Id),
+			sql.Named("account_id", c.AccountId),
+			sql.Named("name", c.Name),
+			sql.Named("color", c.Color),
+			sql.Named("rollover", c.Rollover),
+			sql.Named("kind", c.Kind),
+		)
+		if err != nil {
+			return fmt.Errorf("restore category %d: %w", c.Id, err)
+		}
+	}
+
+	for _, b := range doc.Budgets {
+		_, err := r.db.ExecContext(ctx, QLedgerInsertBudget,
+			sql.Named("id", b.Id),
+			sql.Named("account_id", b.AccountId),
+			sql.Named("period_id", b.PeriodId),
+			sql.Named("category_id", b.CategoryId),
+			sql.Named("amount", b.Amount),
+		)
+		if err != nil {
+			return fmt.Errorf("restore budget %d: %w", b.Id, err)
+		}
+	}
+
+	for _, ro := range doc.BudgetRollovers {
+		_, err := r.db.ExecContext(ctx, QLedgerInsertBudgetRollover,
+			sql.Named("id", ro.Id),
+			sql.Named("account_id", ro.AccountId),
+			sql.Named("category_id", ro.CategoryId),
+			sql.Named("from_period_id", ro.FromPeriodId),
+			sql.Named("to_period_id", ro.ToPeriodId),
+			sql.Named("amount", ro.Amount),
+			sql.Named("timestamp_created", ro.CreatedOn),
+		)
+		if err != nil {
+			return fmt.Errorf("restore budget rollover %d: %w", ro.Id, err)
+		}
+	}
+
+	for _, rt := range doc.Recurrings {
+		_, err := r.db.ExecContext(ctx, QLedgerInsertRecurring,
+			sql.Named("id", rt.Id),
+			sql.Named("account_id", rt.AccountId),
+			sql.Named("category_id", rt.CategoryId),
+			sql.Named("name", rt.Name),
+			sql.Named("occurrence_day", rt.Day),
+			sql.Named("amount", rt.Amount),
+			sql.Named("frequency", rt.Frequency),
+			sql.Named("interval_count", rt.Interval),
+			sql.Named("anchor_timestamp", nullableInt64(rt.Anchor)),
+			sql.Named("end_timestamp", nullableInt64(rt.End)),
+			sql.Named("occurrence_count", rt.Count),
+			sql.Named("weekday", rt.Weekday),
+			sql.Named("auto_apply", rt.AutoApply),
+			sql.Named("timestamp_added", rt.AddedOn),
+		)
+		if err != nil {
+			return fmt.Errorf("restore recurring %d: %w", rt.Id, err)
+		}
+	}
+
+	for _, ar := range doc.ActualizedRecurrings {
+		_, err := r.db.ExecContext(ctx, QLedgerInsertActualizedRecurring,
+			sql.Named("id", ar.Id),
+			sql.Named("account_id", ar.AccountId),
+			sql.Named("period_id", ar.PeriodId),
+			sql.Named("based_on_id", ar.BasedOnId),
+			sql.Named("category_snapshot", ar.CategorySnapshot),
+			sql.Named("name_snapshot", ar.NameSnapshot),
+			sql.Named("occurrence_day_snapshot", ar.DaySnapshot),
+			sql.Named("amount_snapshot", ar.AmountSnapshot),
+			sql.Named("actual_amount", nullableInt64(ar.ActualAmount)),
+			sql.Named("occurrence_timestamp", nullableInt64(ar.Occurrence)),
+			sql.Named("timestamp_created", ar.CreatedOn),
+		)
+		if err != nil {
+			return fmt.Errorf("restore actualized recurring %d: %w", ar.Id, err)
+		}
+	}
+
+	for _, p := range doc.ImportProfiles {
+		_, err := r.db.ExecContext(ctx, QLedgerInsertImportProfile,
+			sql.Named("id", p.Id),
+			sql.Named("account_id", p.AccountId),
+			sql.Named("name", p.Name),
+			sql.Named("delimiter", p.Delimiter),
+			sql.Named("date_format", p.DateFormat),
+			sql.Named("header_rows", p.HeaderRows),
+			sql.Named("date_column", p.DateColumn),
+			sql.Named("name_column", p.NameColumn),
+			sql.Named("amount_column", p.AmountColumn),
+			sql.Named("debit_column", p.DebitColumn),
+			sql.Named("credit_column", p.CreditColumn),
+			sql.Named("negate_amounts", p.NegateAmounts),
+		)
+		if err != nil {
+			return fmt.Errorf("restore import profile %d: %w", p.Id, err)
+		}
+	}
+
+	for _, b := range doc.ImportBatches {
+		_, err := r.db.ExecContext(ctx, QLedgerInsertImportBatch,
+			sql.Named("id", b.Id),
+			sql.Named("account_id", b.AccountId),
+			sql.Named("source", b.Source),
+			sql.Named("file_name", b.FileName),
+			sql.Named("timestamp_imported", b.ImportedOn),
+		)
+		if err != nil {
+			return fmt.Errorf("restore import batch %d: %w", b.Id, err)
+		}
+	}
+
+	for _, t := range doc.Transfers {
+		_, err := r.db.ExecContext(ctx, QLedgerInsertTransfer,
+			sql.Named("id", t.Id),
+			sql.Named("from_account_id", t.FromAccountId),
+			sql.Named("to_account_id", t.ToAccountId),
+			sql.Named("timestamp_created", t.CreatedOn),
+		)
+		if err != nil {
+			return fmt.Errorf("restore transfer %d: %w", t.Id, err)
+		}
+	}
+
+	for _, rec := range doc.Reconciliations {
+		finalized := sql.NullInt64{}
+		if rec.FinalizedOn != nil {
+			finalized = sql.NullInt64{Int64: *rec.FinalizedOn, Valid: true}
+		}
+		_, err := r.db.ExecContext(ctx, QLedgerInsertReconciliation,
+			sql.Named("id", rec.Id),
+			sql.Named("account_id", rec.AccountId),
+			sql.Named("statement_date", rec.StatementDate),
+			sql.Named("statement_balance", rec.StatementBalance),
+			sql.Named("timestamp_started", rec.StartedOn),
+			sql.Named("timestamp_finalized", finalized),
+		)
+		if err != nil {
+			return fmt.Errorf("restore reconciliation %d: %w", rec.Id, err)
+		}
+	}
+
+	for _, t := range doc.Transactions {
+		_, err := r.db.ExecContext(ctx, QLedgerInsertTransaction,
+			sql.Named("id", t.Id),
+			sql.Named("account_id", t.AccountId),
+			sql.Named("period_id", t.PeriodId),
+			sql.Named("category_id", t.CategoryId),
+			sql.Named("actualized_recurring_id", t.ActualizedRecurringId),
+			sql.Named("import_batch_id", sql.NullInt64{Int64: t.ImportBatchId, Valid: t.ImportBatchId != 0}),
+			sql.Named("transaction_date", t.Date),
+			sql.Named("amount", t.Amount),
+			sql.Named("name", t.Name),
+			sql.Named("notes", t.Notes),
+			sql.Named("external_id", sql.NullString{String: t.ExternalId, Valid: t.ExternalId != ""}),
+			sql.Named("transfer_id", sql.NullInt64{Int64: t.TransferId, Valid: t.TransferId != 0}),
+			sql.Named("reconciliation_id", sql.NullInt64{Int64: t.ReconciliationId, Valid: t.ReconciliationId != 0}),
+			sql.Named("status", ledgerStatus(t.Status)),
+			sql.Named("can_delete", t.CanDelete),
+			sql.Named("timestamp_added", t.AddedOn),
+		)
+		if err != nil {
+			return fmt.Errorf("restore transaction %d: %w", t.Id, err)
+		}
+	}
+
+	for _, ts := range doc.TransactionSplits {
+		_, err := r.db.ExecContext(ctx, QLedgerInsertTransactionSplit,
+			sql.Named("id", ts.Id),
+			sql.Named("transaction_id", ts.TransactionId),
+			sql.Named("category_id", ts.CategoryId),
+			sql.Named("amount", ts.Amount),
+			sql.Named("memo", ts.Memo),
+		)
+		if err != nil {
+			return fmt.Errorf("restore transaction split %d: %w", ts.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// ledgerStatus defaults the status of transactions from documents written
+// before transactions had one.
+func ledgerStatus(status string) string {
+	if status == "" {
+		return domain.StatusUncleared
+	}
+	return status
+}
+
+func queryEach(ctx context.Context, db DBTX, query string, scan func(rows *sql.Rows) error) error {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func int64Pointer(n sql.NullInt64) *int64 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Int64
+}
+
+func nullableInt64(p *int64) sql.NullInt64 {
+	if p == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *p, Valid: true}
+}
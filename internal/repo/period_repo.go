@@ -9,10 +9,10 @@ import (
 )
 
 type PeriodRepo struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewPeriodRepo(db *sql.DB) *PeriodRepo {
+func NewPeriodRepo(db DBTX) *PeriodRepo {
 	return &PeriodRepo{db: db}
 }
 
@@ -56,7 +56,39 @@ func (r *PeriodRepo) StartPeriod(ctx context.Context, accountId int64, reportSta
 	return p, nil
 }
 
-const QGetActivePeriod = `
+// runningBalances adds the cleared and working balance columns for period p.
+// Both start from the account's first opening balance and add the
+// transactions of p and every earlier period, cleared only counting those
+// that are cleared or reconciled.
+var runningBalances = `
+	(select coalesce(sum(rt.amount), 0)
+	 from transactions rt
+	 join periods rp on rp.id = rt.period_id
+	 where rt.account_id = p.account_id
+	   and rp.reporting_start_timestamp <= p.reporting_start_timestamp
+	   and ((rt.can_delete = true and rt.status != 'uncleared') or rt.id = ` + firstOpeningBalance("p.account_id") + `)) cleared_balance,
+	(select coalesce(sum(rt.amount), 0)
+	 from transactions rt
+	 join periods rp on rp.id = rt.period_id
+	 where rt.account_id = p.account_id
+	   and rp.reporting_start_timestamp <= p.reporting_start_timestamp
+	   and (rt.can_delete = true or rt.id = ` + firstOpeningBalance("p.account_id") + `)) working_balance`
+
+// firstOpeningBalance is a subquery selecting the id of the first opening
+// balance of the account given by the accountId expression. Later opening
+// balances only carry the previous period's balance forward.
+func firstOpeningBalance(accountId string) string {
+	return `
+	(select ot.id
+	 from transactions ot
+	 join periods op on op.id = ot.period_id
+	 where ot.account_id = ` + accountId + `
+	   and ot.can_delete = false
+	 order by op.reporting_start_timestamp, ot.id
+	 limit 1)`
+}
+
+var QGetActivePeriod = `
 with tx as (select t.account_id, t.period_id, coalesce(sum(t.amount), 0) balance
             from transactions t
             group by t.account_id, t.period_id)
@@ -66,7 +98,8 @@ select
 	p.reporting_end_timestamp, 
 	p.opened_on_timestamp, 
 	p.closed_on_timestamp,
-	t.balance
+	t.balance,
+	` + runningBalances + `
 from periods p
 left join tx t on t.period_id = p.id
 where p.account_id = @account_id
@@ -74,6 +107,7 @@ where p.account_id = @account_id
 order by id
 limit 1
 `
+
 const ActivePeriodId = 0
 
 // GetPeriod Pass periodId ActivePeriodId (0) to get the latest active period
@@ -88,7 +122,7 @@ func (r *PeriodRepo) GetPeriod(ctx context.Context, accountId int64, periodId in
 	var endMillis int64
 	var openedMillis int64
 	var closedMillis sql.NullInt64
-	err := row.Scan(&p.Id, &startMillis, &endMillis, &openedMillis, &closedMillis, &p.Balance)
+	err := row.Scan(&p.Id, &startMillis, &endMillis, &openedMillis, &closedMillis, &p.Balance, &p.ClearedBalance, &p.WorkingBalance)
 
 	if err != nil {
 		return p, fmt.Errorf("scan active period account %d: %w", accountId, err)
@@ -105,6 +139,136 @@ func (r *PeriodRepo) GetPeriod(ctx context.Context, accountId int64, periodId in
 	return p, nil
 }
 
+var QListPeriods = `
+select p.id
+     , p.account_id
+     , p.reporting_start_timestamp
+     , p.reporting_end_timestamp
+     , p.opened_on_timestamp
+     , p.closed_on_timestamp
+     , coalesce(sum(case when t.can_delete = false then t.amount end), 0) opening_balance
+     , coalesce(sum(case when t.can_delete = true and t.amount > 0 then t.amount end), 0) inflow
+     , coalesce(sum(case when t.can_delete = true and t.amount < 0 then t.amount end), 0) outflow
+     , coalesce(sum(t.amount), 0) balance
+     , ` + runningBalances + `
+from periods p
+left join transactions t on t.period_id = p.id
+where p.account_id = @account_id
+group by p.id
+order by p.reporting_start_timestamp desc
+       , p.id desc
+`
+
+// ListPeriods returns every period for the account, newest first.
+func (r *PeriodRepo) ListPeriods(ctx context.Context, accountId int64) ([]domain.PeriodSummary, error) {
+	rows, err := r.db.QueryContext(ctx, QListPeriods, sql.Named("account_id", accountId))
+	if err != nil {
+		return nil, fmt.Errorf("query list periods account %d: %w", accountId, err)
+	}
+	defer rows.Close()
+
+	results := make([]domain.PeriodSummary, 0, 12)
+	for rows.Next() {
+		var p domain.PeriodSummary
+		var startMillis int64
+		var endMillis int64
+		var openedMillis int64
+		var closedMillis sql.NullInt64
+		err := rows.Scan(
+			&p.Id,
+			&p.AccountId,
+			&startMillis,
+			&endMillis,
+			&openedMillis,
+			&closedMillis,
+			&p.OpeningBalance,
+			&p.Inflow,
+			&p.Outflow,
+			&p.Balance,
+			&p.ClearedBalance,
+			&p.WorkingBalance,
+		)
+		if err != nil {
+			return results, fmt.Errorf("scan list periods: %w", err)
+		}
+
+		p.ReportingStart = time.UnixMilli(startMillis).UTC()
+		p.ReportingEnd = time.UnixMilli(endMillis).UTC()
+		p.OpenedOn = time.UnixMilli(openedMillis).UTC()
+		if closedMillis.Valid {
+			p.ClosedOn = time.UnixMilli(closedMillis.Int64).UTC()
+		}
+
+		results = append(results, p)
+	}
+
+	return results, nil
+}
+
+const QPeriodForDate = `
+select p.id
+     , p.account_id
+     , p.reporting_start_timestamp
+     , p.reporting_end_timestamp
+     , p.opened_on_timestamp
+     , p.closed_on_timestamp
+from periods p
+where p.account_id = @account_id
+  and date(p.reporting_start_timestamp / 1000, 'unixepoch') <= date(@date / 1000, 'unixepoch')
+  and date(p.reporting_end_timestamp / 1000, 'unixepoch') >= date(@date / 1000, 'unixepoch')
+order by p.id desc
+limit 1
+`
+
+// PeriodForDate returns the account's period whose reporting range covers the
+// calendar day of date. Balance is not populated.
+func (r *PeriodRepo) PeriodForDate(ctx context.Context, accountId int64, date time.Time) (domain.Period, error) {
+	row := r.db.QueryRowContext(ctx, QPeriodForDate,
+		sql.Named("account_id", accountId),
+		sql.Named("date", date.UnixMilli()),
+	)
+
+	var p domain.Period
+	var startMillis int64
+	var endMillis int64
+	var openedMillis int64
+	var closedMillis sql.NullInt64
+	err := row.Scan(&p.Id, &p.AccountId, &startMillis, &endMillis, &openedMillis, &closedMillis)
+	if err != nil {
+		return p, fmt.Errorf("scan period for %s account %d: %w", date.Format(time.DateOnly), accountId, err)
+	}
+
+	p.ReportingStart = time.UnixMilli(startMillis).UTC()
+	p.ReportingEnd = time.UnixMilli(endMillis).UTC()
+	p.OpenedOn = time.UnixMilli(openedMillis).UTC()
+	if closedMillis.Valid {
+		p.ClosedOn = time.UnixMilli(closedMillis.Int64).UTC()
+	}
+
+	return p, nil
+}
+
+const QPreviousPeriodId = `
+select prev.id
+from periods p
+join periods prev on prev.account_id = p.account_id
+where p.id = @period_id
+  and prev.reporting_start_timestamp < p.reporting_start_timestamp
+order by prev.reporting_start_timestamp desc
+limit 1
+`
+
+// PreviousPeriodId returns the id of the period before periodId. The error
+// wraps sql.ErrNoRows for an account's first period.
+func (r *PeriodRepo) PreviousPeriodId(ctx context.Context, periodId int64) (int64, error) {
+	var id int64
+	err := r.db.QueryRowContext(ctx, QPreviousPeriodId, sql.Named("period_id", periodId)).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("scan previous period of %d: %w", periodId, err)
+	}
+	return id, nil
+}
+
 const QClosePeriod = `update periods set closed_on_timestamp = @closed_on_timestamp where id = @id`
 
 func (r *PeriodRepo) ClosePeriod(ctx context.Context, periodId int64) error {
@@ -0,0 +1,217 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+type ReconciliationRepo struct {
+	db DBTX
+}
+
+func NewReconciliationRepo(db DBTX) *ReconciliationRepo {
+	return &ReconciliationRepo{db: db}
+}
+
+const QInsertReconciliation = `
+	insert into reconciliations (account_id, statement_date, statement_balance, timestamp_started)
+	values (@account_id, @statement_date, @statement_balance, @timestamp_started)
+	returning id, account_id, statement_date, statement_balance, timestamp_started, timestamp_finalized
+`
+
+func (r *ReconciliationRepo) Add(ctx context.Context, rec domain.Reconciliation) (domain.Reconciliation, error) {
+	row := r.db.QueryRowContext(ctx, QInsertReconciliation,
+		sql.Named("account_id", rec.AccountId),
+		sql.Named("statement_date", rec.StatementDate.UnixMilli()),
+		sql.Named("statement_balance", rec.StatementBalance),
+		sql.Named("timestamp_started", time.Now().UnixMilli()),
+	)
+
+	rec, err := scanReconciliation(row)
+	if err != nil {
+		return rec, fmt.Errorf("add reconciliation: %w", err)
+	}
+	return rec, nil
+}
+
+const QSingleReconciliation = `
+	select id, account_id, statement_date, statement_balance, timestamp_started, timestamp_finalized
+	from reconciliations
+	where id = @id
+`
+
+func (r *ReconciliationRepo) Single(ctx context.Context, id int64) (domain.Reconciliation, error) {
+	row := r.db.QueryRowContext(ctx, QSingleReconciliation, sql.Named("id", id))
+	rec, err := scanReconciliation(row)
+	if err != nil {
+		return rec, fmt.Errorf("query single reconciliation %d: %w", id, err)
+	}
+	return rec, nil
+}
+
+const QOpenReconciliation = `
+	select id, account_id, statement_date, statement_balance, timestamp_started, timestamp_finalized
+	from reconciliations
+	where account_id = @account_id
+	  and timestamp_finalized is null
+`
+
+// Open returns the account's unfinished reconciliation. The error wraps
+// sql.ErrNoRows when there is none.
+func (r *ReconciliationRepo) Open(ctx context.Context, accountId int64) (domain.Reconciliation, error) {
+	row := r.db.QueryRowContext(ctx, QOpenReconciliation, sql.Named("account_id", accountId))
+	rec, err := scanReconciliation(row)
+	if err != nil {
+		return rec, fmt.Errorf("query open reconciliation account %d: %w", accountId, err)
+	}
+	return rec, nil
+}
+
+var QClearedBalance = `
+select coalesce(sum(t.amount), 0)
+from transactions t
+where t.account_id = @account_id
+  and t.transaction_date <= @as_of
+  and ((t.can_delete = true and t.status != 'uncleared')
+       or t.id = ` + firstOpeningBalance("@account_id") + `)
+`
+
+// ClearedBalance is the account's first opening balance plus every cleared or
+// reconciled transaction dated on or before asOf.
+func (r *ReconciliationRepo) ClearedBalance(ctx context.Context, accountId int64, asOf time.Time) (int64, error) {
+	var balance int64
+	row := r.db.QueryRowContext(ctx, QClearedBalance,
+		sql.Named("account_id", accountId),
+		sql.Named("as_of", asOf.UnixMilli()),
+	)
+
+	if err := row.Scan(&balance); err != nil {
+		return 0, fmt.Errorf("scan cleared balance account %d: %w", accountId, err)
+	}
+	return balance, nil
+}
+
+const QReconciliationCandidates = `
+select t.id
+     , t.account_id
+     , t.period_id
+     , t.category_id
+     , t.name
+     , t.amount
+     , t.transaction_date
+     , t.actualized_recurring_id
+     , t.can_delete
+     , coalesce(t.external_id, '')
+     , coalesce(t.import_batch_id, 0)
+     , coalesce(t.notes, '')
+     , coalesce(t.transfer_id, 0)
+     , coalesce(t.status, 'uncleared')
+from transactions t
+where t.account_id = @account_id
+  and t.can_delete = true
+  and t.status != 'reconciled'
+  and t.transaction_date <= @as_of
+order by t.transaction_date
+       , t.id
+`
+
+// Candidates lists the account's transactions dated on or before asOf that
+// have not been reconciled yet.
+func (r *ReconciliationRepo) Candidates(ctx context.Context, accountId int64, asOf time.Time) ([]domain.Transaction, error) {
+	rows, err := r.db.QueryContext(ctx, QReconciliationCandidates,
+		sql.Named("account_id", accountId),
+		sql.Named("as_of", asOf.UnixMilli()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query reconciliation candidates: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]domain.Transaction, 0, 50)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return results, fmt.Errorf("scan reconciliation candidates: %w", err)
+		}
+		results = append(results, t)
+	}
+
+	return results, nil
+}
+
+const QReconcileCleared = `
+	update transactions
+	set status = 'reconciled',
+	    reconciliation_id = @reconciliation_id
+	where account_id = @account_id
+	  and status = 'cleared'
+	  and transaction_date <= @as_of
+`
+
+const QFinalizeReconciliation = `
+	update reconciliations
+	set timestamp_finalized = @timestamp_finalized
+	where id = @id
+	returning id, account_id, statement_date, statement_balance, timestamp_started, timestamp_finalized
+`
+
+// Finalize locks every cleared transaction dated on or before the statement
+// date as reconciled and closes the reconciliation.
+func (r *ReconciliationRepo) Finalize(ctx context.Context, rec domain.Reconciliation) (domain.Reconciliation, error) {
+	_, err := r.db.ExecContext(ctx, QReconcileCleared,
+		sql.Named("reconciliation_id", rec.Id),
+		sql.Named("account_id", rec.AccountId),
+		sql.Named("as_of", rec.StatementDate.UnixMilli()),
+	)
+	if err != nil {
+		return rec, fmt.Errorf("reconcile cleared transactions: %w", err)
+	}
+
+	row := r.db.QueryRowContext(ctx, QFinalizeReconciliation,
+		sql.Named("id", rec.Id),
+		sql.Named("timestamp_finalized", time.Now().UnixMilli()),
+	)
+
+	finalized, err := scanReconciliation(row)
+	if err != nil {
+		return rec, fmt.Errorf("finalize reconciliation %d: %w", rec.Id, err)
+	}
+	return finalized, nil
+}
+
+const QDeleteReconciliation = `
+	delete from reconciliations
+	where id = @id
+	  and timestamp_finalized is null
+`
+
+// Delete cancels an unfinished reconciliation. Cleared marks are kept.
+func (r *ReconciliationRepo) Delete(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, QDeleteReconciliation, sql.Named("id", id))
+	if err != nil {
+		return fmt.Errorf("delete reconciliation %d: %w", id, err)
+	}
+	return nil
+}
+
+func scanReconciliation(row interface{ Scan(dest ...any) error }) (domain.Reconciliation, error) {
+	var rec domain.Reconciliation
+	var statementMillis int64
+	var startedMillis int64
+	var finalizedMillis sql.NullInt64
+
+	err := row.Scan(&rec.Id, &rec.AccountId, &statementMillis, &rec.StatementBalance, &startedMillis, &finalizedMillis)
+	if err != nil {
+		return rec, fmt.Errorf("scan reconciliation: %w", err)
+	}
+
+	rec.StatementDate = time.UnixMilli(statementMillis).UTC()
+	rec.StartedOn = time.UnixMilli(startedMillis).UTC()
+	if finalizedMillis.Valid {
+		rec.FinalizedOn = time.UnixMilli(finalizedMillis.Int64).UTC()
+	}
+	return rec, nil
+}
@@ -9,33 +9,44 @@ import (
 )
 
 type RecurringRepo struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewRecurringsRepo(db *sql.DB) *RecurringRepo {
+func NewRecurringsRepo(db DBTX) *RecurringRepo {
 	return &RecurringRepo{db: db}
 }
 
+const recurringColumns = `
+	  id
+	, account_id
+	, category_id
+	, name
+	, amount
+	, occurrence_day
+	, frequency
+	, interval_count
+	, anchor_timestamp
+	, end_timestamp
+	, occurrence_count
+	, weekday
+	, auto_apply
+`
+
 const QListRecurrings = `
-	select r.id
-		 , r.account_id
-		 , r.category_id
-		 , r.name
-		 , r.amount
-		 , r.occurrence_day
-		 , (select count(1) from actualized_recurrings ar where ar.period_id = @period_id and ar.based_on_id = r.id) > 0 as accounted
+	select ` + recurringColumns + `
      from recurrings r
 	where account_id = @account_id
 	order by r.occurrence_day 
 			,r.timestamp_added desc
 `
 
+// List returns the account's recurrings with the dates each falls on within
+// the period and which of those are already actualized.
 func (r *RecurringRepo) List(ctx context.Context, accountId int64, periodId int64) ([]domain.Recurring, error) {
 	result := make([]domain.Recurring, 0, 20)
 
 	rows, err := r.db.QueryContext(ctx, QListRecurrings,
 		sql.Named("account_id", accountId),
-		sql.Named("period_id", periodId),
 	)
 	if err != nil {
 		return result, fmt.Errorf("query list recurrings: %w", err)
@@ -43,19 +54,130 @@ func (r *RecurringRepo) List(ctx context.Context, accountId int64, periodId int6
 
 	defer rows.Close()
 
-	var rt domain.Recurring
 	for rows.Next() {
-		err := rows.Scan(&rt.Id, &rt.AccountId, &rt.CategoryId, &rt.Name, &rt.Amount, &rt.Day, &rt.AccountedInPeriod)
+		rt, err := scanRecurring(rows)
 		if err != nil {
 			return result, fmt.Errorf("scan list recurring: %w", err)
 		}
 
 		result = append(result, rt)
 	}
+	if err := rows.Err(); err != nil {
+		return result, fmt.Errorf("list recurrings: %w", err)
+	}
+	rows.Close()
+
+	err = r.withOccurrences(ctx, periodId, result)
+	if err != nil {
+		return result, fmt.Errorf("list recurrings: %w", err)
+	}
 
 	return result, nil
 }
 
+// Occurrences returns the dates the recurring falls on within the period and
+// which of those are already actualized.
+func (r *RecurringRepo) Occurrences(ctx context.Context, rt domain.Recurring, periodId int64) ([]domain.RecurringOccurrence, error) {
+	list := []domain.Recurring{rt}
+	err := r.withOccurrences(ctx, periodId, list)
+	if err != nil {
+		return nil, fmt.Errorf("occurrences of recurring %d: %w", rt.Id, err)
+	}
+	return list[0].Occurrences, nil
+}
+
+const QPeriodBounds = `
+	select reporting_start_timestamp, reporting_end_timestamp from periods where id = @period_id
+`
+
+const QPeriodActualizations = `
+	select id, based_on_id, occurrence_timestamp
+	from actualized_recurrings
+	where period_id = @period_id
+	  and based_on_id is not null
+	order by id
+`
+
+// withOccurrences fills in each recurring's occurrences in the period and
+// marks those accounted for by an actualized recurring. Actualizations made
+// before recurrence rules existed carry no date, and those dated on an
+// occurrence the rule no longer produces were made before the rule changed.
+// Both account for the earliest occurrences left, oldest first.
+func (r *RecurringRepo) withOccurrences(ctx context.Context, periodId int64, recurrings []domain.Recurring) error {
+	var startMillis, endMillis int64
+	err := r.db.QueryRowContext(ctx, QPeriodBounds, sql.Named("period_id", periodId)).Scan(&startMillis, &endMillis)
+	if err != nil {
+		return fmt.Errorf("period %d bounds: %w", periodId, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, QPeriodActualizations, sql.Named("period_id", periodId))
+	if err != nil {
+		return fmt.Errorf("query period %d actualizations: %w", periodId, err)
+	}
+	defer rows.Close()
+
+	dated := make(map[int64]map[time.Time]int64)
+	ids := make(map[int64][]int64)
+	for rows.Next() {
+		var id, basedOnId int64
+		var occurrenceMillis sql.NullInt64
+		err := rows.Scan(&id, &basedOnId, &occurrenceMillis)
+		if err != nil {
+			return fmt.Errorf("scan period actualization: %w", err)
+		}
+
+		ids[basedOnId] = append(ids[basedOnId], id)
+		if !occurrenceMillis.Valid {
+			continue
+		}
+		if dated[basedOnId] == nil {
+			dated[basedOnId] = make(map[time.Time]int64)
+		}
+		dated[basedOnId][time.UnixMilli(occurrenceMillis.Int64).UTC()] = id
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("period %d actualizations: %w", periodId, err)
+	}
+
+	start := time.UnixMilli(startMillis).UTC()
+	end := time.UnixMilli(endMillis).UTC()
+	for i := range recurrings {
+		rt := &recurrings[i]
+		dates := rt.OccurrencesBetween(start, end)
+
+		matched := make(map[int64]bool)
+		rt.Occurrences = make([]domain.RecurringOccurrence, len(dates))
+		for j, d := range dates {
+			id := dated[rt.Id][d]
+			rt.Occurrences[j] = domain.RecurringOccurrence{Date: d, ActualizedRecurringId: id}
+			matched[id] = true
+		}
+
+		var left []int64
+		for _, id := range ids[rt.Id] {
+			if !matched[id] {
+				left = append(left, id)
+			}
+		}
+
+		pending := 0
+		for j := range rt.Occurrences {
+			if rt.Occurrences[j].Actualized() {
+				continue
+			}
+			if len(left) > 0 {
+				rt.Occurrences[j].ActualizedRecurringId = left[0]
+				left = left[1:]
+				continue
+			}
+			pending++
+		}
+		rt.AccountedInPeriod = pending == 0
+	}
+
+	return nil
+}
+
 const QInsertRecurring = `
 	insert into recurrings (
 		  account_id
@@ -63,10 +185,17 @@ const QInsertRecurring = `
 	    , name
 	    , amount
 	    , occurrence_day
+	    , frequency
+	    , interval_count
+	    , anchor_timestamp
+	    , end_timestamp
+	    , occurrence_count
+	    , weekday
+	    , auto_apply
 	    , timestamp_added)
-	values (@account_id, @category_id, @name, @amount, @occurrence_day, @timestamp_added)
-	returning id, account_id, category_id, name, amount, occurrence_day
-`
+	values (@account_id, @category_id, @name, @amount, @occurrence_day, @frequency, @interval_count,
+	        @anchor_timestamp, @end_timestamp, @occurrence_count, @weekday, @auto_apply, @timestamp_added)
+	returning ` + recurringColumns
 
 func (r *RecurringRepo) Add(ctx context.Context, rt domain.Recurring) (domain.Recurring, error) {
 	row := r.db.QueryRowContext(ctx, QInsertRecurring,
@@ -75,10 +204,17 @@ func (r *RecurringRepo) Add(ctx context.Context, rt domain.Recurring) (domain.Re
 		sql.Named("name", rt.Name),
 		sql.Named("amount", rt.Amount),
 		sql.Named("occurrence_day", rt.Day),
+		sql.Named("frequency", rt.Frequency),
+		sql.Named("interval_count", rt.Interval),
+		sql.Named("anchor_timestamp", nullMillis(rt.Anchor)),
+		sql.Named("end_timestamp", nullMillis(rt.Until)),
+		sql.Named("occurrence_count", rt.Count),
+		sql.Named("weekday", int(rt.Weekday)),
+		sql.Named("auto_apply", rt.AutoApply),
 		sql.Named("timestamp_added", time.Now().UnixMilli()),
 	)
 
-	err := row.Scan(&rt.Id, &rt.AccountId, &rt.CategoryId, &rt.Name, &rt.Amount, &rt.Day)
+	rt, err := scanRecurring(row)
 	if err != nil {
 		return rt, fmt.Errorf("scan recurring: %w", err)
 	}
@@ -86,12 +222,7 @@ func (r *RecurringRepo) Add(ctx context.Context, rt domain.Recurring) (domain.Re
 }
 
 const QSingleRecurring = `
-	select r.id
-	 	 , r.account_id 
-	 	 , r.category_id 
-		 , r.name
-		 , r.amount
-		 , r.occurrence_day
+	select ` + recurringColumns + `
     from
 	recurrings r
 	where r.id = @id
@@ -99,8 +230,7 @@ const QSingleRecurring = `
 
 func (r *RecurringRepo) Single(ctx context.Context, id int64) (domain.Recurring, error) {
 	row := r.db.QueryRowContext(ctx, QSingleRecurring, sql.Named("id", id))
-	var rt domain.Recurring
-	err := row.Scan(&rt.Id, &rt.AccountId, &rt.CategoryId, &rt.Name, &rt.Amount, &rt.Day)
+	rt, err := scanRecurring(row)
 	if err != nil {
 		return rt, fmt.Errorf("scan single recurring %d: %w", id, err)
 	}
@@ -109,10 +239,12 @@ func (r *RecurringRepo) Single(ctx context.Context, id int64) (domain.Recurring,
 
 const QUpdateRecurring = `
 	update recurrings
-	set category_id = @category_id, name = @name, occurrence_day = @day, amount = @amount
+	set category_id = @category_id, name = @name, occurrence_day = @day, amount = @amount,
+	    frequency = @frequency, interval_count = @interval_count, anchor_timestamp = @anchor_timestamp,
+	    end_timestamp = @end_timestamp, occurrence_count = @occurrence_count, weekday = @weekday,
+	    auto_apply = @auto_apply
 	where id = @id
-	returning id, account_id, category_id, name, amount, occurrence_day
-`
+	returning ` + recurringColumns
 
 func (r *RecurringRepo) Update(ctx context.Context, rt domain.Recurring) (domain.Recurring, error) {
 	row := r.db.QueryRowContext(ctx, QUpdateRecurring,
@@ -121,11 +253,19 @@ func (r *RecurringRepo) Update(ctx context.Context, rt domain.Recurring) (domain
 		sql.Named("name", rt.Name),
 		sql.Named("day", rt.Day),
 		sql.Named("amount", rt.Amount),
+		sql.Named("frequency", rt.Frequency),
+		sql.Named("interval_count", rt.Interval),
+		sql.Named("anchor_timestamp", nullMillis(rt.Anchor)),
+		sql.Named("end_timestamp", nullMillis(rt.Until)),
+		sql.Named("occurrence_count", rt.Count),
+		sql.Named("weekday", int(rt.Weekday)),
+		sql.Named("auto_apply", rt.AutoApply),
 	)
 
-	err := row.Scan(&rt.Id, &rt.AccountId, &rt.CategoryId, &rt.Name, &rt.Amount, &rt.Day)
+	id := rt.Id
+	rt, err := scanRecurring(row)
 	if err != nil {
-		return rt, fmt.Errorf("scan update recurring %d: %w", rt.Id, err)
+		return rt, fmt.Errorf("scan update recurring %d: %w", id, err)
 	}
 	return rt, nil
 }
@@ -143,13 +283,16 @@ func (r *RecurringRepo) Delete(ctx context.Context, rt domain.Recurring) error {
 }
 
 const QInsertActualizedRecurring = `
-	insert into actualized_recurrings (account_id, period_id, based_on_id, name_snapshot, amount_snapshot, occurrence_day_snapshot, timestamp_created)
-	values (@account_id, @period_id, @based_on_id, @name, @amount, @day, @date)
-	returning id, account_id, period_id, name_snapshot, amount_snapshot, occurrence_day_snapshot
+	insert into actualized_recurrings (account_id, period_id, based_on_id, name_snapshot, amount_snapshot, actual_amount,
+	                                   occurrence_day_snapshot, occurrence_timestamp, timestamp_created)
+	values (@account_id, @period_id, @based_on_id, @name, @amount, @actual_amount, @day, @occurrence, @date)
+	returning id, account_id, period_id, name_snapshot, amount_snapshot, actual_amount, occurrence_day_snapshot
 `
 
-// ActualizeRecurring Returns ActualizedRecurring record, category id from the source recurring record, and any error.
-func (r *RecurringRepo) ActualizeRecurring(ctx context.Context, recurringId int64, periodId int64) (domain.ActualizedRecurring, int64, error) {
+// ActualizeRecurring records the recurring's occurrence on the given date as accounted for in the period
+// along with the amount it actually came to.
+// Returns ActualizedRecurring record, category id from the source recurring record, and any error.
+func (r *RecurringRepo) ActualizeRecurring(ctx context.Context, recurringId int64, periodId int64, occurrence time.Time, actualAmount int64) (domain.ActualizedRecurring, int64, error) {
 	var ar domain.ActualizedRecurring
 	rt, err := r.Single(ctx, recurringId)
 	if err != nil {
@@ -158,10 +301,13 @@ func (r *RecurringRepo) ActualizeRecurring(ctx context.Context, recurringId int6
 
 	ar.Date = time.Now().UTC()
 	ar.AccountId = rt.AccountId
+	ar.BasedOnId = recurringId
 	ar.PeriodId = periodId
 	ar.Name = rt.Name
 	ar.Amount = rt.Amount
-	ar.Day = rt.Day
+	ar.ActualAmount = actualAmount
+	ar.Day = uint8(occurrence.Day())
+	ar.Occurrence = occurrence
 
 	row := r.db.QueryRowContext(ctx, QInsertActualizedRecurring,
 		sql.Named("account_id", ar.AccountId),
@@ -169,9 +315,91 @@ func (r *RecurringRepo) ActualizeRecurring(ctx context.Context, recurringId int6
 		sql.Named("based_on_id", recurringId),
 		sql.Named("name", ar.Name),
 		sql.Named("amount", ar.Amount),
+		sql.Named("actual_amount", ar.ActualAmount),
 		sql.Named("day", ar.Day),
+		sql.Named("occurrence", ar.Occurrence.UnixMilli()),
 		sql.Named("date", ar.Date.UnixMilli()))
 
-	err = row.Scan(&ar.Id, &ar.AccountId, &ar.PeriodId, &ar.Name, &ar.Amount, &ar.Day)
+	err = row.Scan(&ar.Id, &ar.AccountId, &ar.PeriodId, &ar.Name, &ar.Amount, &ar.ActualAmount, &ar.Day)
 	return ar, rt.CategoryId, err
 }
+
+const QActualizedInPeriod = `
+	select id
+	     , account_id
+	     , period_id
+	     , coalesce(based_on_id, 0)
+	     , name_snapshot
+	     , amount_snapshot
+	     , coalesce(actual_amount, amount_snapshot)
+	     , occurrence_day_snapshot
+	     , occurrence_timestamp
+	     , timestamp_created
+	from actualized_recurrings
+	where account_id = @account_id
+	  and period_id = @period_id
+	order by coalesce(occurrence_timestamp, timestamp_created), id
+`
+
+// ActualizedInPeriod lists the recurrings actualized in the period with
+// their expected and actual amounts.
+func (r *RecurringRepo) ActualizedInPeriod(ctx context.Context, accountId int64, periodId int64) ([]domain.ActualizedRecurring, error) {
+	rows, err := r.db.QueryContext(ctx, QActualizedInPeriod,
+		sql.Named("account_id", accountId),
+		sql.Named("period_id", periodId),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query actualized recurrings: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]domain.ActualizedRecurring, 0, 20)
+	for rows.Next() {
+		var ar domain.ActualizedRecurring
+		var occurrenceMillis sql.NullInt64
+		var createdMillis int64
+		err := rows.Scan(&ar.Id, &ar.AccountId, &ar.PeriodId, &ar.BasedOnId, &ar.Name, &ar.Amount, &ar.ActualAmount,
+			&ar.Day, &occurrenceMillis, &createdMillis)
+		if err != nil {
+			return result, fmt.Errorf("scan actualized recurring: %w", err)
+		}
+
+		ar.Date = time.UnixMilli(createdMillis).UTC()
+		if occurrenceMillis.Valid {
+			ar.Occurrence = time.UnixMilli(occurrenceMillis.Int64).UTC()
+		}
+		result = append(result, ar)
+	}
+
+	return result, nil
+}
+
+func scanRecurring(row interface{ Scan(dest ...any) error }) (domain.Recurring, error) {
+	var rt domain.Recurring
+	var anchorMillis sql.NullInt64
+	var endMillis sql.NullInt64
+	var weekday int
+
+	err := row.Scan(&rt.Id, &rt.AccountId, &rt.CategoryId, &rt.Name, &rt.Amount, &rt.Day,
+		&rt.Frequency, &rt.Interval, &anchorMillis, &endMillis, &rt.Count, &weekday, &rt.AutoApply)
+	if err != nil {
+		return rt, err
+	}
+
+	rt.Weekday = time.Weekday(weekday)
+	if anchorMillis.Valid {
+		rt.Anchor = time.UnixMilli(anchorMillis.Int64).UTC()
+	}
+	if endMillis.Valid {
+		rt.Until = time.UnixMilli(endMillis.Int64).UTC()
+	}
+	return rt, nil
+}
+
+// nullMillis stores the zero time as null.
+func nullMillis(t time.Time) sql.NullInt64 {
+	if t.IsZero() {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: t.UnixMilli(), Valid: true}
+}
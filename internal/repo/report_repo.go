@@ -0,0 +1,284 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+type ReportRepo struct {
+	db DBTX
+}
+
+func NewReportRepo(db DBTX) *ReportRepo {
+	return &ReportRepo{db: db}
+}
+
+// reportLines is a cte of (transaction_id, period_id, transaction_date,
+// category_id, amount) for the account's transactions in @period_id, or when
+// it is 0 the ones dated from @from through @to. A period includes every
+// transaction filed under it whatever its date, matching the budget and
+// category totals. Each split is its own line. Opening balances and transfers
+// are left out since they are not income or spending.
+const reportLines = `
+lines as (
+	select t.id transaction_id
+	     , t.period_id
+	     , t.transaction_date
+	     , coalesce(s.category_id, t.category_id) category_id
+	     , coalesce(s.amount, t.amount) amount
+	from transactions t
+	left join transaction_splits s on s.transaction_id = t.id
+	where t.account_id = @account_id
+	  and (@period_id = 0 or t.period_id = @period_id)
+	  and (@period_id != 0
+	       or date(t.transaction_date / 1000, 'unixepoch')
+	          between date(@from / 1000, 'unixepoch') and date(@to / 1000, 'unixepoch'))
+	  and t.can_delete = true
+	  and t.transfer_id is null
+)`
+
+const QSpendingByCategory = `
+with ` + reportLines + `
+select coalesce(c.id, 0)
+     , coalesce(c.account_id, 0)
+     , coalesce(c.name, 'Uncategorized')
+     , coalesce(c.color, '')
+     , sum(case when l.amount > 0 then l.amount else 0 end)
+     , sum(case when l.amount < 0 then l.amount else 0 end)
+     , count(distinct l.transaction_id)
+from lines l
+left join categories c on c.id = l.category_id
+group by coalesce(c.id, 0)
+order by 6, 5 desc
+`
+
+// SpendingByCategory sums inflows and outflows per category for the
+// account's transactions in periodId, or when it is 0 the ones dated from
+// through to.
+func (r *ReportRepo) SpendingByCategory(ctx context.Context, accountId int64, periodId int64, from time.Time, to time.Time) ([]domain.CategorySpending, error) {
+	rows, err := r.db.QueryContext(ctx, QSpendingByCategory,
+		sql.Named("account_id", accountId),
+		sql.Named("period_id", periodId),
+		sql.Named("from", from.UnixMilli()),
+		sql.Named("to", to.UnixMilli()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query spending by category: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]domain.CategorySpending, 0, 10)
+	for rows.Next() {
+		var cs domain.CategorySpending
+		err := rows.Scan(&cs.Id, &cs.AccountId, &cs.Name, &cs.Color, &cs.Inflow, &cs.Outflow, &cs.Count)
+		if err != nil {
+			return result, fmt.Errorf("scan spending by category: %w", err)
+		}
+		result = append(result, cs)
+	}
+
+	return result, rows.Err()
+}
+
+const QIncomeExpense = `
+with ` + reportLines + `,
+classified as (
+	select l.period_id
+	     , cast(strftime('%Y', l.transaction_date / 1000, 'unixepoch') as integer) year
+	     , l.amount
+	     , case
+	           when c.kind = 'income' then true
+	           when c.kind = 'expense' then false
+	           else l.amount > 0
+	       end is_income
+	from lines l
+	left join categories c on c.id = l.category_id
+)
+select cl.period_id
+     , p.reporting_start_timestamp
+     , p.reporting_end_timestamp
+     , cl.year
+     , sum(case when cl.is_income then cl.amount else 0 end)
+     , -sum(case when cl.is_income then 0 else cl.amount end)
+from classified cl
+join periods p on p.id = cl.period_id
+group by cl.period_id, cl.year
+order by p.reporting_start_timestamp, cl.year
+`
+
+// IncomeExpense totals income and expense per period and calendar year for
+// the account's transactions in periodId, or when it is 0 the ones dated from
+// through to. Expense is positive. Lines are classified by their
+// category's kind, or by sign when it is auto or they are uncategorized.
+func (r *ReportRepo) IncomeExpense(ctx context.Context, accountId int64, periodId int64, from time.Time, to time.Time) ([]domain.IncomeExpense, error) {
+	rows, err := r.db.QueryContext(ctx, QIncomeExpense,
+		sql.Named("account_id", accountId),
+		sql.Named("period_id", periodId),
+		sql.Named("from", from.UnixMilli()),
+		sql.Named("to", to.UnixMilli()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query income and expense: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]domain.IncomeExpense, 0, 12)
+	for rows.Next() {
+		var ie domain.IncomeExpense
+		var start, end int64
+		err := rows.Scan(&ie.PeriodId, &start, &end, &ie.Year, &ie.Income, &ie.Expense)
+		if err != nil {
+			return result, fmt.Errorf("scan income and expense: %w", err)
+		}
+		ie.Start = time.UnixMilli(start).UTC()
+		ie.End = time.UnixMilli(end).UTC()
+		result = append(result, ie)
+	}
+
+	return result, rows.Err()
+}
+
+const QCategoryTotalsByPeriod = `
+with lines as (
+	select t.period_id
+	     , coalesce(s.category_id, t.category_id) category_id
+	     , coalesce(s.amount, t.amount) amount
+	from transactions t
+	join periods p on p.id = t.period_id
+	left join transaction_splits s on s.transaction_id = t.id
+	where t.account_id = @account_id
+	  and p.reporting_start_timestamp >= @since
+	  and t.can_delete = true
+	  and t.transfer_id is null
+)
+select l.period_id
+     , coalesce(c.id, 0)
+     , coalesce(c.account_id, 0)
+     , coalesce(c.name, 'Uncategorized')
+     , coalesce(c.color, '')
+     , sum(l.amount)
+     , count(1)
+from lines l
+left join categories c on c.id = l.category_id
+group by l.period_id, coalesce(c.id, 0)
+`
+
+// CategoryTotalsByPeriod sums each category's transactions per period for
+// the account's periods starting on or after since, keyed by period id.
+// Opening balances and transfers are left out.
+func (r *ReportRepo) CategoryTotalsByPeriod(ctx context.Context, accountId int64, since time.Time) (map[int64][]domain.CategoryTotal, error) {
+	totals := make(map[int64][]domain.CategoryTotal)
+
+	rows, err := r.db.QueryContext(ctx, QCategoryTotalsByPeriod,
+		sql.Named("account_id", accountId),
+		sql.Named("since", since.UnixMilli()),
+	)
+	if err != nil {
+		return totals, fmt.Errorf("query category totals by period: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var periodId int64
+		var t domain.CategoryTotal
+		err := rows.Scan(&periodId, &t.Id, &t.AccountId, &t.Name, &t.Color, &t.Amount, &t.Count)
+		if err != nil {
+			return totals, fmt.Errorf("scan category totals by period: %w", err)
+		}
+		totals[periodId] = append(totals[periodId], t)
+	}
+
+	return totals, rows.Err()
+}
+
+var QDailyChanges = `
+select t.account_id
+     , date(t.transaction_date / 1000, 'unixepoch') day
+     , sum(t.amount)
+from transactions t
+where t.can_delete = true
+   or t.id = ` + firstOpeningBalance("t.account_id") + `
+group by t.account_id, day
+order by day, t.account_id
+`
+
+// DailyChanges nets every account's transactions per day, oldest first.
+// Only the first opening balance of each account is counted since later ones
+// carry forward the previous period's balance.
+func (r *ReportRepo) DailyChanges(ctx context.Context) ([]domain.DailyChange, error) {
+	rows, err := r.db.QueryContext(ctx, QDailyChanges)
+	if err != nil {
+		return nil, fmt.Errorf("query daily changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := make([]domain.DailyChange, 0, 100)
+	for rows.Next() {
+		var c domain.DailyChange
+		var day string
+		err := rows.Scan(&c.AccountId, &day, &c.Amount)
+		if err != nil {
+			return changes, fmt.Errorf("scan daily change: %w", err)
+		}
+
+		date, err := time.Parse(time.DateOnly, day)
+		if err != nil {
+			return changes, fmt.Errorf("parse daily change date %q: %w", day, err)
+		}
+		c.Date = date.Add(12 * time.Hour)
+		changes = append(changes, c)
+	}
+
+	return changes, rows.Err()
+}
+
+const QPeriodTransactionsByDate = `
+select t.id
+     , t.account_id
+     , t.period_id
+     , t.category_id
+     , t.name
+     , t.amount
+     , t.transaction_date
+     , t.actualized_recurring_id
+     , t.can_delete
+     , coalesce(t.external_id, '')
+     , coalesce(t.import_batch_id, 0)
+     , coalesce(t.notes, '')
+     , coalesce(t.transfer_id, 0)
+     , coalesce(t.status, 'uncleared')
+from transactions t
+where t.account_id = @account_id
+  and t.period_id = @period_id
+order by t.can_delete
+       , t.transaction_date
+       , t.timestamp_added
+       , t.id
+`
+
+// PeriodTransactionsByDate lists every transaction of the period oldest
+// first, with the opening balance ahead of the rest.
+func (r *ReportRepo) PeriodTransactionsByDate(ctx context.Context, accountId int64, periodId int64) ([]domain.Transaction, error) {
+	rows, err := r.db.QueryContext(ctx, QPeriodTransactionsByDate,
+		sql.Named("account_id", accountId),
+		sql.Named("period_id", periodId),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query period transactions by date: %w", err)
+	}
+	defer rows.Close()
+
+	transactions := make([]domain.Transaction, 0, 50)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return transactions, err
+		}
+		transactions = append(transactions, t)
+	}
+
+	return transactions, rows.Err()
+}
@@ -0,0 +1,125 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+type TransactionSplitRepo struct {
+	db DBTX
+}
+
+func NewTransactionSplitRepo(db DBTX) *TransactionSplitRepo {
+	return &TransactionSplitRepo{db: db}
+}
+
+const QSplitsForTransactions = `
+select s.id, s.transaction_id, s.category_id, s.amount, coalesce(s.memo, '')
+from transaction_splits s
+where s.transaction_id in (%s)
+order by s.transaction_id, s.id
+`
+
+// ListForTransactions returns the splits of each transaction keyed by
+// transaction id. Transactions without splits are left out of the map.
+func (r *TransactionSplitRepo) ListForTransactions(ctx context.Context, transactionIds []int64) (map[int64][]domain.TransactionSplit, error) {
+	splits := make(map[int64][]domain.TransactionSplit)
+	if len(transactionIds) == 0 {
+		return splits, nil
+	}
+
+	params := make([]string, len(transactionIds))
+	args := make([]any, len(transactionIds))
+	for i, id := range transactionIds {
+		name := fmt.Sprintf("transaction_id_%d", i)
+		params[i] = "@" + name
+		args[i] = sql.Named(name, id)
+	}
+
+	query := fmt.Sprintf(QSplitsForTransactions, strings.Join(params, ", "))
+	err := r.collect(ctx, splits, query, args...)
+	if err != nil {
+		return splits, fmt.Errorf("list splits for transactions: %w", err)
+	}
+
+	return splits, nil
+}
+
+const QSplitsForAccount = `
+select s.id, s.transaction_id, s.category_id, s.amount, coalesce(s.memo, '')
+from transaction_splits s
+join transactions t on t.id = s.transaction_id
+where t.account_id = @account_id
+order by s.transaction_id, s.id
+`
+
+// ListForAccount returns the splits of every transaction in the account keyed
+// by transaction id.
+func (r *TransactionSplitRepo) ListForAccount(ctx context.Context, accountId int64) (map[int64][]domain.TransactionSplit, error) {
+	splits := make(map[int64][]domain.TransactionSplit)
+	err := r.collect(ctx, splits, QSplitsForAccount, sql.Named("account_id", accountId))
+	if err != nil {
+		return splits, fmt.Errorf("list splits for account %d: %w", accountId, err)
+	}
+
+	return splits, nil
+}
+
+func (r *TransactionSplitRepo) collect(ctx context.Context, splits map[int64][]domain.TransactionSplit, query string, args ...any) error {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var s domain.TransactionSplit
+		err := rows.Scan(&s.Id, &s.TransactionId, &s.CategoryId, &s.Amount, &s.Memo)
+		if err != nil {
+			return fmt.Errorf("scan split: %w", err)
+		}
+		splits[s.TransactionId] = append(splits[s.TransactionId], s)
+	}
+
+	return rows.Err()
+}
+
+const QDeleteSplits = `
+	delete from transaction_splits where transaction_id = @transaction_id
+`
+
+const QInsertSplit = `
+	insert into transaction_splits (transaction_id, category_id, amount, memo)
+	values (@transaction_id, @category_id, @amount, @memo)
+	returning id, transaction_id, category_id, amount, coalesce(memo, '')
+`
+
+// Replace swaps the transaction's splits for the given ones. An empty list
+// leaves the transaction unsplit.
+func (r *TransactionSplitRepo) Replace(ctx context.Context, transactionId int64, splits []domain.TransactionSplit) ([]domain.TransactionSplit, error) {
+	_, err := r.db.ExecContext(ctx, QDeleteSplits, sql.Named("transaction_id", transactionId))
+	if err != nil {
+		return nil, fmt.Errorf("delete splits for transaction %d: %w", transactionId, err)
+	}
+
+	saved := make([]domain.TransactionSplit, 0, len(splits))
+	for _, s := range splits {
+		row := r.db.QueryRowContext(ctx, QInsertSplit,
+			sql.Named("transaction_id", transactionId),
+			sql.Named("category_id", s.CategoryId),
+			sql.Named("amount", s.Amount),
+			sql.Named("memo", s.Memo),
+		)
+
+		err := row.Scan(&s.Id, &s.TransactionId, &s.CategoryId, &s.Amount, &s.Memo)
+		if err != nil {
+			return saved, fmt.Errorf("insert split for transaction %d: %w", transactionId, err)
+		}
+		saved = append(saved, s)
+	}
+
+	return saved, nil
+}
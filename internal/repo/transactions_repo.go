@@ -3,19 +3,25 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"html"
+	"strings"
 	"time"
 	"tjdickerson/sacbooks/internal/domain"
 )
 
 type TransactionRepo struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewTransactionRepo(db *sql.DB) *TransactionRepo {
+func NewTransactionRepo(db DBTX) *TransactionRepo {
 	return &TransactionRepo{db: db}
 }
 
+var ErrorCantDeleteTransaction = fmt.Errorf("can't delete transaction")
+var ErrorReconciledTransaction = fmt.Errorf("transaction is reconciled")
+
 const QPagedTransactions = `
 select t.id
      , t.account_id
@@ -25,6 +31,12 @@ select t.id
      , t.amount
      , t.transaction_date
      , t.actualized_recurring_id
+     , t.can_delete
+     , coalesce(t.external_id, '')
+     , coalesce(t.import_batch_id, 0)
+     , coalesce(t.notes, '')
+     , coalesce(t.transfer_id, 0)
+     , coalesce(t.status, 'uncleared')
 from transactions t
 where account_id = @account_id
   and period_id = @period_id
@@ -67,6 +79,292 @@ func (r *TransactionRepo) List(ctx context.Context, accountId int64, periodId in
 	return results, nil
 }
 
+const QTransactionsForPeriods = `
+select t.id
+     , t.account_id
+     , t.period_id
+     , t.category_id
+     , t.name
+     , t.amount
+     , t.transaction_date
+     , t.actualized_recurring_id
+     , t.can_delete
+     , coalesce(t.external_id, '')
+     , coalesce(t.import_batch_id, 0)
+     , coalesce(t.notes, '')
+     , coalesce(t.transfer_id, 0)
+     , coalesce(t.status, 'uncleared')
+from transactions t
+join periods p on p.id = t.period_id
+where t.account_id = @account_id
+  and (@from_period_id = 0 or p.reporting_start_timestamp >= (select reporting_start_timestamp from periods where id = @from_period_id))
+  and (@to_period_id = 0 or p.reporting_start_timestamp <= (select reporting_start_timestamp from periods where id = @to_period_id))
+order by p.reporting_start_timestamp
+       , t.can_delete
+       , t.transaction_date
+       , t.id
+`
+
+// ListForPeriods returns the account's transactions from every period between
+// the two periods inclusive, oldest first with each opening balance leading its
+// period. A period id of 0 leaves that end of the range open.
+func (r *TransactionRepo) ListForPeriods(ctx context.Context, accountId int64, fromPeriodId int64, toPeriodId int64) ([]domain.Transaction, error) {
+	rows, err := r.db.QueryContext(ctx, QTransactionsForPeriods,
+		sql.Named("account_id", accountId),
+		sql.Named("from_period_id", fromPeriodId),
+		sql.Named("to_period_id", toPeriodId),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query transactions for periods: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]domain.Transaction, 0, 100)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return results, fmt.Errorf("scan transactions for periods: %w", err)
+		}
+		results = append(results, t)
+	}
+
+	return results, nil
+}
+
+const QSearchTransactionsSelect = `
+select t.id
+     , t.account_id
+     , t.period_id
+     , t.category_id
+     , t.name
+     , t.amount
+     , t.transaction_date
+     , t.actualized_recurring_id
+     , t.can_delete
+     , coalesce(t.external_id, '')
+     , coalesce(t.import_batch_id, 0)
+     , coalesce(t.notes, '')
+     , coalesce(t.transfer_id, 0)
+     , coalesce(t.status, 'uncleared')
+from transactions t
+`
+
+const QSearchTransactionsCount = `
+select count(1)
+from transactions t
+`
+
+// searchSortColumns whitelists the columns a search can be ordered by.
+var searchSortColumns = map[string]string{
+	"":       "t.transaction_date",
+	"date":   "t.transaction_date",
+	"amount": "t.amount",
+	"name":   "t.name collate nocase",
+}
+
+var ErrorInvalidSort = fmt.Errorf("invalid sort column")
+
+// likeEscaper makes text match literally in a like pattern using escape '\'.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// Search returns one page of transactions matching the filter along with the
+// total number of matches across every page.
+func (r *TransactionRepo) Search(ctx context.Context, f domain.TransactionFilter) ([]domain.Transaction, int, error) {
+	sortColumn, ok := searchSortColumns[f.SortBy]
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: %s", ErrorInvalidSort, f.SortBy)
+	}
+
+	conditions := make([]string, 0, 8)
+	args := make([]any, 0, 8+len(f.CategoryIds))
+
+	if f.AccountId != 0 {
+		conditions = append(conditions, "t.account_id = @account_id")
+		args = append(args, sql.Named("account_id", f.AccountId))
+	}
+	if f.Text != "" {
+		conditions = append(conditions, `(t.name like '%' || @text || '%' escape '\' or t.notes like '%' || @text || '%' escape '\')`)
+		args = append(args, sql.Named("text", likeEscaper.Replace(f.Text)))
+	}
+	if f.MinAmount != nil {
+		conditions = append(conditions, "t.amount >= @min_amount")
+		args = append(args, sql.Named("min_amount", *f.MinAmount))
+	}
+	if f.MaxAmount != nil {
+		conditions = append(conditions, "t.amount <= @max_amount")
+		args = append(args, sql.Named("max_amount", *f.MaxAmount))
+	}
+	if !f.StartDate.IsZero() {
+		conditions = append(conditions, "t.transaction_date >= @start_date")
+		args = append(args, sql.Named("start_date", f.StartDate.UnixMilli()))
+	}
+	if !f.EndDate.IsZero() {
+		conditions = append(conditions, "t.transaction_date <= @end_date")
+		args = append(args, sql.Named("end_date", f.EndDate.UnixMilli()))
+	}
+	if len(f.CategoryIds) > 0 {
+		params := make([]string, 0, len(f.CategoryIds))
+		for i, id := range f.CategoryIds {
+			name := fmt.Sprintf("category_%d", i)
+			params = append(params, "@"+name)
+			args = append(args, sql.Named(name, id))
+		}
+		in := strings.Join(params, ", ")
+		conditions = append(conditions, "(t.category_id in ("+in+")"+
+			" or exists (select 1 from transaction_splits s where s.transaction_id = t.id and s.category_id in ("+in+")))")
+	}
+	switch f.Origin {
+	case domain.OriginRecurring:
+		conditions = append(conditions, "coalesce(t.actualized_recurring_id, 0) != 0")
+	case domain.OriginManual:
+		conditions = append(conditions, "coalesce(t.actualized_recurring_id, 0) = 0 and t.transfer_id is null")
+	case domain.OriginTransfer:
+		conditions = append(conditions, "t.transfer_id is not null")
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "where " + strings.Join(conditions, "\n  and ") + "\n"
+	}
+
+	var total int
+	err := r.db.QueryRowContext(ctx, QSearchTransactionsCount+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("scan search transactions count: %w", err)
+	}
+
+	query := QSearchTransactionsSelect + where
+	direction := "asc"
+	if f.SortDesc {
+		direction = "desc"
+	}
+	query += fmt.Sprintf("order by %s %s, t.id %s\nlimit @limit offset @offset", sortColumn, direction, direction)
+	args = append(args, sql.Named("limit", f.Limit), sql.Named("offset", f.Offset))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query search transactions: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]domain.Transaction, 0, f.Limit)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return results, total, fmt.Errorf("scan search transactions: %w", err)
+		}
+		results = append(results, t)
+	}
+
+	return results, total, nil
+}
+
+// Highlight markers are control characters so the text around them can be
+// escaped before they are swapped for HTML tags.
+const (
+	matchStart = "\x02"
+	matchEnd   = "\x03"
+)
+
+const QFullTextSearch = `
+select t.id
+     , t.account_id
+     , t.period_id
+     , t.category_id
+     , t.name
+     , t.amount
+     , t.transaction_date
+     , t.actualized_recurring_id
+     , t.can_delete
+     , coalesce(t.external_id, '')
+     , coalesce(t.import_batch_id, 0)
+     , coalesce(t.notes, '')
+     , coalesce(t.transfer_id, 0)
+     , coalesce(t.status, 'uncleared')
+     , highlight(transactions_fts, 0, @match_start, @match_end)
+     , coalesce(snippet(transactions_fts, 1, @match_start, @match_end, '…', 12), '')
+     , bm25(transactions_fts)
+from transactions_fts f
+join transactions t on t.id = f.rowid
+where transactions_fts match @query
+  and (@account_id = 0 or t.account_id = @account_id)
+order by bm25(transactions_fts)
+       , t.transaction_date desc
+limit @limit offset @offset
+`
+
+const QFullTextIndexExists = `
+	select count(1) from sqlite_master where type = 'table' and name = 'transactions_fts'
+`
+
+// HasFullTextIndex reports whether the full text index exists. It is missing
+// when sqlite was built without FTS5.
+func (r *TransactionRepo) HasFullTextIndex(ctx context.Context) (bool, error) {
+	var exists int
+	err := r.db.QueryRowContext(ctx, QFullTextIndexExists).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("scan full text index exists: %w", err)
+	}
+	return exists > 0, nil
+}
+
+// FullTextSearch ranks transactions across every period, and every account
+// when accountId is 0, against an FTS5 match expression.
+func (r *TransactionRepo) FullTextSearch(ctx context.Context, accountId int64, query string, limit int, offset int) ([]domain.TransactionMatch, error) {
+	rows, err := r.db.QueryContext(ctx, QFullTextSearch,
+		sql.Named("query", query),
+		sql.Named("account_id", accountId),
+		sql.Named("match_start", matchStart),
+		sql.Named("match_end", matchEnd),
+		sql.Named("limit", limit),
+		sql.Named("offset", offset),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query full text search: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]domain.TransactionMatch, 0, limit)
+	for rows.Next() {
+		var m domain.TransactionMatch
+		var dateMillis int64
+		err := rows.Scan(
+			&m.Id,
+			&m.AccountId,
+			&m.PeriodId,
+			&m.CategoryId,
+			&m.Name,
+			&m.Amount,
+			&dateMillis,
+			&m.ActualizedRecurringId,
+			&m.CanDelete,
+			&m.ExternalId,
+			&m.ImportBatchId,
+			&m.Notes,
+			&m.TransferId,
+			&m.Status,
+			&m.NameHighlight,
+			&m.NotesSnippet,
+			&m.Rank,
+		)
+		if err != nil {
+			return results, fmt.Errorf("scan full text search: %w", err)
+		}
+
+		m.Date = time.UnixMilli(dateMillis).UTC()
+		m.NameHighlight = markMatches(m.NameHighlight)
+		m.NotesSnippet = markMatches(m.NotesSnippet)
+		results = append(results, m)
+	}
+
+	return results, nil
+}
+
+func markMatches(text string) string {
+	escaped := html.EscapeString(text)
+	return strings.NewReplacer(matchStart, "<mark>", matchEnd, "</mark>").Replace(escaped)
+}
+
 const QSingleTransaction = `
 select t.id
      , t.account_id
@@ -76,6 +374,12 @@ select t.id
      , t.amount
      , t.transaction_date
      , t.actualized_recurring_id
+     , t.can_delete
+     , coalesce(t.external_id, '')
+     , coalesce(t.import_batch_id, 0)
+     , coalesce(t.notes, '')
+     , coalesce(t.transfer_id, 0)
+     , coalesce(t.status, 'uncleared')
 from transactions t
 where t.id = @transaction_id
 `
@@ -96,9 +400,10 @@ update transactions
 set name        		= @name,
     amount      		= @amount,
     transaction_date    = @date,
-    category_id 		= @category_id
+    category_id 		= @category_id,
+    notes               = @notes
 where id = @id
-returning id, account_id, period_id, category_id, name, amount, transaction_date, actualized_recurring_id 
+returning id, account_id, period_id, category_id, name, amount, transaction_date, actualized_recurring_id, can_delete, coalesce(external_id, ''), coalesce(import_batch_id, 0), coalesce(notes, ''), coalesce(transfer_id, 0), coalesce(status, 'uncleared')
 `
 
 func (r *TransactionRepo) Update(ctx context.Context, t domain.Transaction) (domain.Transaction, error) {
@@ -108,6 +413,7 @@ func (r *TransactionRepo) Update(ctx context.Context, t domain.Transaction) (dom
 		sql.Named("amount", t.Amount),
 		sql.Named("date", t.Date.UnixMilli()),
 		sql.Named("category_id", t.CategoryId),
+		sql.Named("notes", t.Notes),
 	)
 
 	return scanTransaction(row)
@@ -122,7 +428,13 @@ const QInsertTransaction = `
 	    , category_id
 	    , actualized_recurring_id
 	    , period_id
-	    , timestamp_added)
+	    , timestamp_added
+	    , can_delete
+	    , external_id
+	    , import_batch_id
+	    , notes
+	    , transfer_id
+	    , status)
 	values (
 		@transaction_date, 
 		@amount, 
@@ -131,8 +443,14 @@ const QInsertTransaction = `
 		@category_id,
 		@actualized_recurring_id,
 		@period_id,
-		@timestamp_added)
-returning id, account_id, period_id, category_id, name, amount, transaction_date, actualized_recurring_id 
+		@timestamp_added,
+		@can_delete,
+		@external_id,
+		@import_batch_id,
+		@notes,
+		@transfer_id,
+		coalesce(nullif(@status, ''), 'uncleared'))
+returning id, account_id, period_id, category_id, name, amount, transaction_date, actualized_recurring_id, can_delete, coalesce(external_id, ''), coalesce(import_batch_id, 0), coalesce(notes, ''), coalesce(transfer_id, 0), coalesce(status, 'uncleared')
 `
 
 func (r *TransactionRepo) Add(ctx context.Context, t domain.Transaction) (domain.Transaction, error) {
@@ -145,6 +463,12 @@ func (r *TransactionRepo) Add(ctx context.Context, t domain.Transaction) (domain
 		sql.Named("actualized_recurring_id", t.ActualizedRecurringId),
 		sql.Named("period_id", t.PeriodId),
 		sql.Named("timestamp_added", time.Now().UnixMilli()),
+		sql.Named("can_delete", t.CanDelete),
+		sql.Named("external_id", sql.NullString{String: t.ExternalId, Valid: t.ExternalId != ""}),
+		sql.Named("import_batch_id", sql.NullInt64{Int64: t.ImportBatchId, Valid: t.ImportBatchId != 0}),
+		sql.Named("notes", t.Notes),
+		sql.Named("transfer_id", sql.NullInt64{Int64: t.TransferId, Valid: t.TransferId != 0}),
+		sql.Named("status", t.Status),
 	)
 
 	return scanTransaction(row)
@@ -155,6 +479,12 @@ const QDeleteTransaction = `
 `
 
 func (r *TransactionRepo) Delete(ctx context.Context, t domain.Transaction) error {
+	if !t.CanDelete {
+		return ErrorCantDeleteTransaction
+	}
+	if t.Status == domain.StatusReconciled {
+		return ErrorReconciledTransaction
+	}
 	_, err := r.db.ExecContext(ctx, QDeleteTransaction, sql.Named("id", t.Id))
 	if err != nil {
 		return fmt.Errorf("exec delete transaction %d: %w", t.Id, err)
@@ -162,6 +492,110 @@ func (r *TransactionRepo) Delete(ctx context.Context, t domain.Transaction) erro
 	return nil
 }
 
+const QTransferCounterpart = `
+select t.id
+     , t.account_id
+     , t.period_id
+     , t.category_id
+     , t.name
+     , t.amount
+     , t.transaction_date
+     , t.actualized_recurring_id
+     , t.can_delete
+     , coalesce(t.external_id, '')
+     , coalesce(t.import_batch_id, 0)
+     , coalesce(t.notes, '')
+     , coalesce(t.transfer_id, 0)
+     , coalesce(t.status, 'uncleared')
+from transactions t
+where t.transfer_id = @transfer_id
+  and t.id != @transaction_id
+`
+
+// TransferCounterpart returns the other side of the transfer t belongs to.
+func (r *TransactionRepo) TransferCounterpart(ctx context.Context, t domain.Transaction) (domain.Transaction, error) {
+	row := r.db.QueryRowContext(ctx, QTransferCounterpart,
+		sql.Named("transfer_id", t.TransferId),
+		sql.Named("transaction_id", t.Id),
+	)
+
+	other, err := scanTransaction(row)
+	if err != nil {
+		return other, fmt.Errorf("query transfer %d counterpart: %w", t.TransferId, err)
+	}
+
+	return other, nil
+}
+
+const QSetTransactionStatus = `
+update transactions
+set status = @status
+where id = @id
+  and status != 'reconciled'
+returning id, account_id, period_id, category_id, name, amount, transaction_date, actualized_recurring_id, can_delete, coalesce(external_id, ''), coalesce(import_batch_id, 0), coalesce(notes, ''), coalesce(transfer_id, 0), coalesce(status, 'uncleared')
+`
+
+// SetStatus marks the transaction uncleared or cleared. Reconciled
+// transactions are left as they are and report ErrorReconciledTransaction.
+func (r *TransactionRepo) SetStatus(ctx context.Context, id int64, status string) (domain.Transaction, error) {
+	row := r.db.QueryRowContext(ctx, QSetTransactionStatus,
+		sql.Named("id", id),
+		sql.Named("status", status),
+	)
+
+	t, err := scanTransaction(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return t, fmt.Errorf("set status of transaction %d: %w", id, ErrorReconciledTransaction)
+	}
+	if err != nil {
+		return t, fmt.Errorf("set status of transaction %d: %w", id, err)
+	}
+
+	return t, nil
+}
+
+const QAdjustOpeningBalances = `
+	update transactions set amount = amount + @amount
+	where account_id = @account_id
+	  and can_delete = false
+`
+
+// AdjustOpeningBalances adds amount to every opening balance of the account.
+// The first one starts the account's running balances and each later one
+// carries the balance forward, so all of them move together.
+func (r *TransactionRepo) AdjustOpeningBalances(ctx context.Context, accountId int64, amount int64) error {
+	_, err := r.db.ExecContext(ctx, QAdjustOpeningBalances,
+		sql.Named("account_id", accountId),
+		sql.Named("amount", amount),
+	)
+	if err != nil {
+		return fmt.Errorf("adjust opening balances of account %d: %w", accountId, err)
+	}
+	return nil
+}
+
+const QExternalIdExists = `
+	select count(1) from transactions
+	where account_id = @account_id
+	  and external_id = @external_id
+`
+
+// ExternalIdExists reports whether a transaction with the bank's id has
+// already been imported into the account.
+func (r *TransactionRepo) ExternalIdExists(ctx context.Context, accountId int64, externalId string) (bool, error) {
+	var count int
+	row := r.db.QueryRowContext(ctx, QExternalIdExists,
+		sql.Named("account_id", accountId),
+		sql.Named("external_id", externalId),
+	)
+
+	if err := row.Scan(&count); err != nil {
+		return false, fmt.Errorf("scan external id exists %s: %w", externalId, err)
+	}
+
+	return count > 0, nil
+}
+
 func scanTransaction(row interface{ Scan(dest ...any) error }) (domain.Transaction, error) {
 	var t domain.Transaction
 	var dateMillis int64
@@ -175,6 +609,12 @@ func scanTransaction(row interface{ Scan(dest ...any) error }) (domain.Transacti
 		&t.Amount,
 		&dateMillis,
 		&t.ActualizedRecurringId,
+		&t.CanDelete,
+		&t.ExternalId,
+		&t.ImportBatchId,
+		&t.Notes,
+		&t.TransferId,
+		&t.Status,
 	)
 
 	if err != nil {
@@ -0,0 +1,86 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+)
+
+type TransferRepo struct {
+	db DBTX
+}
+
+func NewTransferRepo(db DBTX) *TransferRepo {
+	return &TransferRepo{db: db}
+}
+
+const QInsertTransfer = `
+	insert into transfers (from_account_id, to_account_id, timestamp_created)
+	values (@from_account_id, @to_account_id, @timestamp_created)
+	returning id, from_account_id, to_account_id, timestamp_created
+`
+
+func (r *TransferRepo) Add(ctx context.Context, t domain.Transfer) (domain.Transfer, error) {
+	row := r.db.QueryRowContext(ctx, QInsertTransfer,
+		sql.Named("from_account_id", t.FromAccountId),
+		sql.Named("to_account_id", t.ToAccountId),
+		sql.Named("timestamp_created", time.Now().UnixMilli()),
+	)
+
+	return scanTransfer(row)
+}
+
+const QListTransfers = `
+	select id, from_account_id, to_account_id, timestamp_created
+	from transfers
+	where @account_id = 0 or from_account_id = @account_id or to_account_id = @account_id
+	order by id
+`
+
+// List returns the transfers in or out of the account, or every transfer when
+// accountId is 0, keyed by id.
+func (r *TransferRepo) List(ctx context.Context, accountId int64) (map[int64]domain.Transfer, error) {
+	rows, err := r.db.QueryContext(ctx, QListTransfers, sql.Named("account_id", accountId))
+	if err != nil {
+		return nil, fmt.Errorf("list transfers: %w", err)
+	}
+	defer rows.Close()
+
+	transfers := make(map[int64]domain.Transfer)
+	for rows.Next() {
+		t, err := scanTransfer(rows)
+		if err != nil {
+			return transfers, err
+		}
+		transfers[t.Id] = t
+	}
+
+	return transfers, nil
+}
+
+const QDeleteTransfer = `
+	delete from transfers where id = @id
+`
+
+func (r *TransferRepo) Delete(ctx context.Context, transferId int64) error {
+	_, err := r.db.ExecContext(ctx, QDeleteTransfer, sql.Named("id", transferId))
+	if err != nil {
+		return fmt.Errorf("delete transfer %d: %w", transferId, err)
+	}
+	return nil
+}
+
+func scanTransfer(row interface{ Scan(dest ...any) error }) (domain.Transfer, error) {
+	var t domain.Transfer
+	var createdMillis int64
+
+	err := row.Scan(&t.Id, &t.FromAccountId, &t.ToAccountId, &createdMillis)
+	if err != nil {
+		return t, fmt.Errorf("scan transfer: %w", err)
+	}
+
+	t.CreatedOn = time.UnixMilli(createdMillis).UTC()
+	return t, nil
+}
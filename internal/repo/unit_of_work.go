@@ -0,0 +1,80 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// DBTX is satisfied by both *sql.DB and *sql.Tx so repos can run inside a
+// unit of work or directly against the database.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Repos bundles every repo bound to the same connection or transaction.
+type Repos struct {
+	Accounts        *AccountRepo
+	Periods         *PeriodRepo
+	Transactions    *TransactionRepo
+	Splits          *TransactionSplitRepo
+	Transfers       *TransferRepo
+	Reconciliations *ReconciliationRepo
+	Categories      *CategoryRepo
+	Budgets         *BudgetRepo
+	Recurrings      *RecurringRepo
+	Reports         *ReportRepo
+	Profiles        *ImportProfileRepo
+	Batches         *ImportBatchRepo
+	Ledger          *LedgerRepo
+}
+
+func NewRepos(db DBTX) Repos {
+	return Repos{
+		Accounts:        NewAccountRepo(db),
+		Periods:         NewPeriodRepo(db),
+		Transactions:    NewTransactionRepo(db),
+		Splits:          NewTransactionSplitRepo(db),
+		Transfers:       NewTransferRepo(db),
+		Reconciliations: NewReconciliationRepo(db),
+		Categories:      NewCategoryRepo(db),
+		Budgets:         NewBudgetRepo(db),
+		Recurrings:      NewRecurringsRepo(db),
+		Reports:         NewReportRepo(db),
+		Profiles:        NewImportProfileRepo(db),
+		Batches:         NewImportBatchRepo(db),
+		Ledger:          NewLedgerRepo(db),
+	}
+}
+
+type UnitOfWork struct {
+	db *sql.DB
+}
+
+func NewUnitOfWork(db *sql.DB) *UnitOfWork {
+	return &UnitOfWork{db: db}
+}
+
+// Do runs fn with repos bound to a single transaction. The transaction is
+// committed when fn returns nil and rolled back otherwise.
+// The database only allows one open connection, so fn must not use any repo
+// outside of r or it will block on the open transaction.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(r Repos) error) error {
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin unit of work: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(NewRepos(tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit unit of work: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,358 @@
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type Migration struct {
+	Version    int
+	Name       string
+	Statements []string
+	// Requires names a sqlite compile option the migration depends on. The
+	// migration is skipped, and stays pending, while sqlite lacks it.
+	Requires string
+}
+
+// migrations are applied in order and never edited once released. Schema
+// changes are made by appending a new migration with the next version.
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "initial schema",
+		Statements: []string{
+			CreateTableAccounts,
+			CreateTablePeriods,
+			CreateTableCategories,
+			CreateTableTransactions,
+			CreateTableRecurrings,
+			CreateTableActualizedRecurrings,
+			CreateTriggerTransactions,
+		},
+	},
+	{
+		Version: 2,
+		Name:    "protect opening balance transactions",
+		Statements: []string{
+			UpdateOpeningBalanceCanDelete,
+		},
+	},
+	{
+		Version: 3,
+		Name:    "csv import profiles",
+		Statements: []string{
+			CreateTableImportProfiles,
+		},
+	},
+	{
+		Version: 4,
+		Name:    "transaction external ids and import batches",
+		Statements: []string{
+			CreateTableImportBatches,
+			AlterTransactionsAddExternalId,
+			AlterTransactionsAddImportBatchId,
+			CreateIndexTransactionsExternalId,
+		},
+	},
+	{
+		Version: 5,
+		Name:    "transaction notes",
+		Statements: []string{
+			AlterTransactionsAddNotes,
+		},
+	},
+	{
+		Version: 6,
+		Name:    "transaction full text index",
+		Statements: []string{
+			CreateTableTransactionsFts,
+			RebuildTransactionsFts,
+			CreateTriggerTransactionsFtsInsert,
+			CreateTriggerTransactionsFtsDelete,
+			CreateTriggerTransactionsFtsUpdate,
+		},
+		Requires: "ENABLE_FTS5",
+	},
+	{
+		Version: 7,
+		Name:    "transaction splits",
+		Statements: []string{
+			CreateTableTransactionSplits,
+			CreateIndexTransactionSplitsTransactionId,
+			CreateTriggerTransactionSplits,
+		},
+	},
+	{
+		Version: 8,
+		Name:    "transfers between accounts",
+		Statements: []string{
+			CreateTableTransfers,
+			AlterTransactionsAddTransferId,
+			CreateIndexTransactionsTransferId,
+		},
+	},
+	{
+		Version: 9,
+		Name:    "cleared status and reconciliations",
+		Statements: []string{
+			CreateTableReconciliations,
+			CreateIndexReconciliationsOpen,
+			AlterTransactionsAddStatus,
+			AlterTransactionsAddReconciliationId,
+		},
+	},
+	{
+		Version: 10,
+		Name:    "category budgets",
+		Statements: []string{
+			CreateTableBudgets,
+			CreateIndexBudgetsPeriodCategory,
+			CreateTriggerBudgets,
+		},
+	},
+	{
+		Version: 11,
+		Name:    "budget rollover",
+		Statements: []string{
+			AlterCategoriesAddRollover,
+			CreateTableBudgetRollovers,
+			CreateIndexBudgetRolloversPeriodCategory,
+			CreateTriggerBudgetRollovers,
+		},
+	},
+	{
+		Version: 12,
+		Name:    "recurrence rules",
+		Statements: []string{
+			AlterRecurringsAddFrequency,
+			AlterRecurringsAddInterval,
+			AlterRecurringsAddAnchor,
+			AlterRecurringsAddEnd,
+			AlterRecurringsAddOccurrenceCount,
+			AlterRecurringsAddWeekday,
+			AlterActualizedRecurringsAddOccurrence,
+		},
+	},
+	{
+		Version: 13,
+		Name:    "auto-apply recurrings",
+		Statements: []string{
+			AlterRecurringsAddAutoApply,
+		},
+	},
+	{
+		Version: 14,
+		Name:    "actual amounts of actualized recurrings",
+		Statements: []string{
+			AlterActualizedRecurringsAddActualAmount,
+			UpdateActualizedRecurringsActualAmount,
+			CreateTriggerActualizedRecurringAmount,
+		},
+	},
+	{
+		Version: 15,
+		Name:    "category kinds",
+		Statements: []string{
+			AlterCategoriesAddKind,
+		},
+	},
+}
+
+// UpdateOpeningBalanceCanDelete fixes opening balances written before
+// can_delete was stored on insert and defaulted to true. The opening balance
+// is written right after its period is created, so it is the period's first
+// row and never has a category or recurring. Its name is not checked since a
+// user's own transaction may share it.
+const UpdateOpeningBalanceCanDelete = `
+	update transactions set can_delete = false
+	where id in (
+		select min(t.id)
+		from transactions t
+		join periods p on p.id = t.period_id
+		group by t.period_id
+	)
+	  and coalesce(category_id, 0) = 0
+	  and coalesce(actualized_recurring_id, 0) = 0
+`
+
+const CreateTableSchemaVersion = `
+	create table if not exists schema_version (
+		version integer primary key,
+		name varchar(100),
+		applied_on_timestamp integer
+	);
+`
+
+const QSchemaVersionExists = `
+	select count(1) from sqlite_master where type = 'table' and name = 'schema_version'
+`
+
+const QCurrentSchemaVersion = `
+	select coalesce(max(version), 0) from schema_version
+`
+
+const QAppliedSchemaVersions = `
+	select version from schema_version
+`
+
+const QCompileOptionUsed = `
+	select sqlite_compileoption_used(@option)
+`
+
+const QInsertSchemaVersion = `
+	insert into schema_version (version, name, applied_on_timestamp)
+	values (@version, @name, @applied_on_timestamp)
+`
+
+// CurrentVersion returns the last applied migration version, 0 for a database
+// that has never been migrated.
+func CurrentVersion(ctx context.Context, db *sql.DB) (int, error) {
+	var exists int
+	err := db.QueryRowContext(ctx, QSchemaVersionExists).Scan(&exists)
+	if err != nil {
+		return 0, fmt.Errorf("scan schema version exists: %w", err)
+	}
+
+	if exists == 0 {
+		return 0, nil
+	}
+
+	var version int
+	err = db.QueryRowContext(ctx, QCurrentSchemaVersion).Scan(&version)
+	if err != nil {
+		return 0, fmt.Errorf("scan current schema version: %w", err)
+	}
+
+	return version, nil
+}
+
+// Pending lists the migrations that have not been applied yet without
+// changing the database. Migrations requiring a compile option sqlite was
+// built without are left out until it is available.
+func Pending(ctx context.Context, db *sql.DB) ([]Migration, error) {
+	applied, err := appliedVersions(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]Migration, 0, len(migrations))
+	for _, m := range migrations {
+		if applied[m.Version] {
+			continue
+		}
+
+		if m.Requires != "" {
+			var used bool
+			err := db.QueryRowContext(ctx, QCompileOptionUsed, sql.Named("option", m.Requires)).Scan(&used)
+			if err != nil {
+				return nil, fmt.Errorf("scan compile option %s: %w", m.Requires, err)
+			}
+			if !used {
+				continue
+			}
+		}
+
+		pending = append(pending, m)
+	}
+
+	return pending, nil
+}
+
+func appliedVersions(ctx context.Context, db *sql.DB) (map[int]bool, error) {
+	applied := make(map[int]bool)
+
+	var exists int
+	err := db.QueryRowContext(ctx, QSchemaVersionExists).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("scan schema version exists: %w", err)
+	}
+	if exists == 0 {
+		return applied, nil
+	}
+
+	rows, err := db.QueryContext(ctx, QAppliedSchemaVersions)
+	if err != nil {
+		return nil, fmt.Errorf("query applied schema versions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, fmt.Errorf("scan applied schema version: %w", err)
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
+
+// Migrate applies each migration in its own transaction, stopping at the
+// first failure.
+func Migrate(ctx context.Context, db *sql.DB, pending []Migration) error {
+	for _, m := range pending {
+		if err := applyMigration(ctx, db, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyMigration(ctx context.Context, db *sql.DB, m Migration) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin migration %d: %w", m.Version, err)
+	}
+	defer tx.Rollback()
+
+	statements := append([]string{CreateTableSchemaVersion}, m.Statements...)
+	for _, statement := range statements {
+		_, err := tx.ExecContext(ctx, statement)
+		if err != nil {
+			return fmt.Errorf("exec migration %d [%s]: %w", m.Version, statement, err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, QInsertSchemaVersion,
+		sql.Named("version", m.Version),
+		sql.Named("name", m.Name),
+		sql.Named("applied_on_timestamp", time.Now().UnixMilli()),
+	)
+	if err != nil {
+		return fmt.Errorf("record migration %d: %w", m.Version, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit migration %d: %w", m.Version, err)
+	}
+
+	return nil
+}
+
+const QCountTables = `
+	select count(1) from sqlite_master where type = 'table'
+`
+
+// Backup writes a consistent copy of the database next to dbPath and returns
+// the path of the copy. An empty database has nothing to back up and returns "".
+func Backup(ctx context.Context, db *sql.DB, dbPath string, version int) (string, error) {
+	var tables int
+	err := db.QueryRowContext(ctx, QCountTables).Scan(&tables)
+	if err != nil {
+		return "", fmt.Errorf("scan table count: %w", err)
+	}
+
+	if tables == 0 {
+		return "", nil
+	}
+
+	backupPath := fmt.Sprintf("%s.v%d.%s.bak", dbPath, version, time.Now().Format("20060102150405"))
+	_, err = db.ExecContext(ctx, "vacuum into @path", sql.Named("path", backupPath))
+	if err != nil {
+		return "", fmt.Errorf("backup database to %s: %w", backupPath, err)
+	}
+
+	return backupPath, nil
+}
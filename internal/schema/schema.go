@@ -5,16 +5,42 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"log"
 )
 
 var NoAccountError = errors.New("no account exists")
 
-func Ensure(ctx context.Context, db *sql.DB) error {
-	err := createSchema(ctx, db)
+// Ensure migrates the database at dbPath to the latest schema version, backing
+// up the file first when there are migrations to apply.
+func Ensure(ctx context.Context, db *sql.DB, dbPath string) error {
+	version, err := CurrentVersion(ctx, db)
 	if err != nil {
 		return err
 	}
 
+	pending, err := Pending(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) > 0 {
+		backupPath, err := Backup(ctx, db, dbPath, version)
+		if err != nil {
+			return err
+		}
+		if backupPath != "" {
+			log.Printf("backed up schema version %d to %s\n", version, backupPath)
+		}
+
+		for _, m := range pending {
+			log.Printf("applying migration %d: %s\n", m.Version, m.Name)
+		}
+	}
+
+	if err := Migrate(ctx, db, pending); err != nil {
+		return err
+	}
+
 	return checkAccountExists(ctx, db)
 }
 
@@ -34,47 +60,6 @@ func checkAccountExists(ctx context.Context, db *sql.DB) error {
 	return NoAccountError
 }
 
-func createSchema(ctx context.Context, db *sql.DB) error {
-	if err := createTable(ctx, db, CreateTableAccounts); err != nil {
-		return err
-	}
-	if err := createTable(ctx, db, CreateTablePeriods); err != nil {
-		return err
-	}
-	if err := createTable(ctx, db, CreateTableCategories); err != nil {
-		return err
-	}
-	if err := createTable(ctx, db, CreateTableTransactions); err != nil {
-		return err
-	}
-	if err := createTable(ctx, db, CreateTableRecurrings); err != nil {
-		return err
-	}
-	if err := createTable(ctx, db, CreateTableActualizedRecurrings); err != nil {
-		return err
-	}
-
-	// create triggers
-	if err := createTable(ctx, db, CreateTriggerTransactions); err != nil {
-		return err
-	}
-	return nil
-}
-
-func createTable(ctx context.Context, db *sql.DB, statement string) error {
-	stmt, err := db.PrepareContext(ctx, statement)
-	if err != nil {
-		return fmt.Errorf("prepare create table [%s]: %w", statement, err)
-	}
-
-	_, err = stmt.ExecContext(ctx)
-	if err != nil {
-		return fmt.Errorf("exec create table [%s]: %w", statement, err)
-	}
-
-	return nil
-}
-
 const CreateTableTransactions = `
 	create table if not exists transactions (
 		id integer primary key,
@@ -164,3 +149,293 @@ const CreateTableCategories = `
 		foreign key(account_id) references accounts(id)
 	);
 `
+
+const CreateTableImportProfiles = `
+	create table if not exists import_profiles (
+		id integer primary key,
+		account_id integer,
+		name varchar(100),
+		delimiter varchar(1),
+		date_format varchar(20),
+		header_rows integer default 0,
+		date_column integer,
+		name_column integer,
+		amount_column integer default 0,
+		debit_column integer default 0,
+		credit_column integer default 0,
+		negate_amounts boolean default false,
+		foreign key(account_id) references accounts(id)
+	);
+`
+
+const CreateTableImportBatches = `
+	create table if not exists import_batches (
+		id integer primary key,
+		account_id integer,
+		source varchar(10),
+		file_name varchar(260),
+		timestamp_imported integer,
+		foreign key(account_id) references accounts(id)
+	);
+`
+
+const AlterTransactionsAddExternalId = `
+	alter table transactions add column external_id varchar(255);
+`
+
+const AlterTransactionsAddImportBatchId = `
+	alter table transactions add column import_batch_id integer references import_batches(id);
+`
+
+const CreateIndexTransactionsExternalId = `
+	create unique index if not exists transactions_account_external_id
+	on transactions(account_id, external_id)
+	where external_id is not null;
+`
+
+const AlterTransactionsAddNotes = `
+	alter table transactions add column notes varchar(4000);
+`
+
+// CreateTableTransactionsFts indexes transaction names and notes. It is an
+// external content table over transactions kept in sync by the triggers below.
+// Requires sqlite built with FTS5, enabled by the sqlite_fts5 build tag; the
+// migration creating it waits until FTS5 is available.
+const CreateTableTransactionsFts = `
+	create virtual table if not exists transactions_fts using fts5(
+		name,
+		notes,
+		content = 'transactions',
+		content_rowid = 'id',
+		tokenize = 'unicode61 remove_diacritics 2'
+	);
+`
+
+const RebuildTransactionsFts = `
+	insert into transactions_fts(transactions_fts) values ('rebuild');
+`
+
+const CreateTriggerTransactionsFtsInsert = `
+	create trigger if not exists transactions_fts_after_insert
+	after insert on transactions
+	for each row
+	begin
+		insert into transactions_fts(rowid, name, notes) values (new.id, new.name, new.notes);
+	end;
+`
+
+const CreateTriggerTransactionsFtsDelete = `
+	create trigger if not exists transactions_fts_after_delete
+	after delete on transactions
+	for each row
+	begin
+		insert into transactions_fts(transactions_fts, rowid, name, notes) values ('delete', old.id, old.name, old.notes);
+	end;
+`
+
+const CreateTriggerTransactionsFtsUpdate = `
+	create trigger if not exists transactions_fts_after_update
+	after update of name, notes on transactions
+	for each row
+	begin
+		insert into transactions_fts(transactions_fts, rowid, name, notes) values ('delete', old.id, old.name, old.notes);
+		insert into transactions_fts(rowid, name, notes) values (new.id, new.name, new.notes);
+	end;
+`
+
+const CreateTableTransactionSplits = `
+	create table if not exists transaction_splits (
+		id integer primary key,
+		transaction_id integer,
+		category_id integer,
+		amount integer,
+		memo varchar(1000),
+		foreign key(transaction_id) references transactions(id),
+		foreign key(category_id) references categories(id)
+	);
+`
+
+const CreateIndexTransactionSplitsTransactionId = `
+	create index if not exists transaction_splits_transaction_id
+	on transaction_splits(transaction_id);
+`
+
+const CreateTriggerTransactionSplits = `
+	create trigger if not exists delete_splits_on_transaction_delete
+	after delete on transactions
+	for each row
+	begin
+		delete from transaction_splits where transaction_id = old.id;
+	end;
+`
+
+const CreateTableTransfers = `
+	create table if not exists transfers (
+		id integer primary key,
+		from_account_id integer,
+		to_account_id integer,
+		timestamp_created integer,
+		foreign key(from_account_id) references accounts(id),
+		foreign key(to_account_id) references accounts(id)
+	);
+`
+
+const AlterTransactionsAddTransferId = `
+	alter table transactions add column transfer_id integer references transfers(id);
+`
+
+const CreateIndexTransactionsTransferId = `
+	create index if not exists transactions_transfer_id
+	on transactions(transfer_id)
+	where transfer_id is not null;
+`
+
+const CreateTableReconciliations = `
+	create table if not exists reconciliations (
+		id integer primary key,
+		account_id integer,
+		statement_date integer,
+		statement_balance integer,
+		timestamp_started integer,
+		timestamp_finalized integer,
+		foreign key(account_id) references accounts(id)
+	);
+`
+
+// CreateIndexReconciliationsOpen allows one unfinished reconciliation per
+// account.
+const CreateIndexReconciliationsOpen = `
+	create unique index if not exists reconciliations_open_account
+	on reconciliations(account_id)
+	where timestamp_finalized is null;
+`
+
+const AlterTransactionsAddStatus = `
+	alter table transactions add column status varchar(12) not null default 'uncleared';
+`
+
+const AlterTransactionsAddReconciliationId = `
+	alter table transactions add column reconciliation_id integer references reconciliations(id);
+`
+
+const CreateTableBudgets = `
+	create table if not exists budgets (
+		id integer primary key,
+		account_id integer,
+		period_id integer,
+		category_id integer,
+		amount integer,
+		foreign key(account_id) references accounts(id),
+		foreign key(period_id) references periods(id),
+		foreign key(category_id) references categories(id)
+	);
+`
+
+const CreateIndexBudgetsPeriodCategory = `
+	create unique index if not exists budgets_period_category
+	on budgets(period_id, category_id);
+`
+
+const CreateTriggerBudgets = `
+	create trigger if not exists delete_budgets_on_category_delete
+	after delete on categories
+	for each row
+	begin
+		delete from budgets where category_id = old.id;
+	end;
+`
+
+const AlterCategoriesAddRollover = `
+	alter table categories add column rollover boolean not null default false;
+`
+
+const AlterCategoriesAddKind = `
+	alter table categories add column kind varchar(10) not null default 'auto';
+`
+
+const CreateTableBudgetRollovers = `
+	create table if not exists budget_rollovers (
+		id integer primary key,
+		account_id integer,
+		category_id integer,
+		from_period_id integer,
+		to_period_id integer,
+		amount integer,
+		timestamp_created integer,
+		foreign key(account_id) references accounts(id),
+		foreign key(category_id) references categories(id),
+		foreign key(from_period_id) references periods(id),
+		foreign key(to_period_id) references periods(id)
+	);
+`
+
+const CreateIndexBudgetRolloversPeriodCategory = `
+	create unique index if not exists budget_rollovers_period_category
+	on budget_rollovers(to_period_id, category_id);
+`
+
+const CreateTriggerBudgetRollovers = `
+	create trigger if not exists delete_budget_rollovers_on_category_delete
+	after delete on categories
+	for each row
+	begin
+		delete from budget_rollovers where category_id = old.id;
+	end;
+`
+
+const AlterRecurringsAddFrequency = `
+	alter table recurrings add column frequency varchar(10) not null default 'monthly';
+`
+
+const AlterRecurringsAddInterval = `
+	alter table recurrings add column interval_count integer not null default 1;
+`
+
+// AlterRecurringsAddAnchor leaves existing recurrings without an anchor so
+// they keep recurring every month as they always have.
+const AlterRecurringsAddAnchor = `
+	alter table recurrings add column anchor_timestamp integer;
+`
+
+const AlterRecurringsAddEnd = `
+	alter table recurrings add column end_timestamp integer;
+`
+
+const AlterRecurringsAddOccurrenceCount = `
+	alter table recurrings add column occurrence_count integer not null default 0;
+`
+
+const AlterRecurringsAddWeekday = `
+	alter table recurrings add column weekday integer not null default 0;
+`
+
+const AlterActualizedRecurringsAddOccurrence = `
+	alter table actualized_recurrings add column occurrence_timestamp integer;
+`
+
+const AlterRecurringsAddAutoApply = `
+	alter table recurrings add column auto_apply boolean not null default false;
+`
+
+// AlterActualizedRecurringsAddActualAmount keeps amount_snapshot as the
+// expected amount and records what was actually paid next to it.
+const AlterActualizedRecurringsAddActualAmount = `
+	alter table actualized_recurrings add column actual_amount integer;
+`
+
+const UpdateActualizedRecurringsActualAmount = `
+	update actualized_recurrings
+	set actual_amount = coalesce(
+		(select t.amount from transactions t where t.actualized_recurring_id = actualized_recurrings.id),
+		amount_snapshot);
+`
+
+const CreateTriggerActualizedRecurringAmount = `
+	create trigger if not exists update_actualized_recurring_on_transaction_amount
+	after update of amount on transactions
+	for each row
+	when new.actualized_recurring_id is not null
+	begin
+		update actualized_recurrings set actual_amount = new.amount where id = new.actualized_recurring_id;
+	end;
+`
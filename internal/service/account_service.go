@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 	"tjdickerson/sacbooks/internal/domain"
@@ -10,6 +11,7 @@ import (
 )
 
 type AccountService struct {
+	uow             *repo.UnitOfWork
 	accountRepo     *repo.AccountRepo
 	periodRepo      *repo.PeriodRepo
 	transactionRepo *repo.TransactionRepo
@@ -17,11 +19,13 @@ type AccountService struct {
 }
 
 func NewAccountService(
+	uow *repo.UnitOfWork,
 	accountRepo *repo.AccountRepo,
 	periodRepo *repo.PeriodRepo,
 	transactionRepo *repo.TransactionRepo,
 	categoryRepo *repo.CategoryRepo) *AccountService {
 	return &AccountService{
+		uow:             uow,
 		accountRepo:     accountRepo,
 		periodRepo:      periodRepo,
 		transactionRepo: transactionRepo,
@@ -71,23 +75,29 @@ func (as *AccountService) Add(ctx context.Context, name string, periodStartDay u
 		CanDelete:      canDelete,
 	}
 
-	account, err := as.accountRepo.Add(ctx, a)
-	if err != nil {
-		return account, fmt.Errorf("add account: %w", err)
-	}
+	var account domain.Account
+	err := as.uow.Do(ctx, func(r repo.Repos) error {
+		var err error
+		account, err = r.Accounts.Add(ctx, a)
+		if err != nil {
+			return fmt.Errorf("add account: %w", err)
+		}
 
-	category := domain.Category{AccountId: account.Id, Name: "Other", Color: "#cacaca"}
-	_, err = as.categoryRepo.Add(ctx, category)
-	if err != nil {
-		return account, fmt.Errorf("default category for new account %d: %w", account.Id, err)
-	}
+		category := domain.Category{AccountId: account.Id, Name: "Other", Color: "#cacaca"}
+		_, err = r.Categories.Add(ctx, category)
+		if err != nil {
+			return fmt.Errorf("default category for new account %d: %w", account.Id, err)
+		}
 
-	p, err := as.StartPeriod(ctx, account.Id, account.PeriodStartDay, nil)
-	if err != nil {
-		return account, fmt.Errorf("start period for account %d: %w", account.Id, err)
-	}
+		p, err := startPeriod(ctx, r, account.Id, account.PeriodStartDay, nil)
+		if err != nil {
+			return fmt.Errorf("start period for account %d: %w", account.Id, err)
+		}
+
+		account.ActivePeriod = &p
+		return nil
+	})
 
-	account.ActivePeriod = p
 	return account, err
 }
 
@@ -116,7 +126,93 @@ func (as *AccountService) Delete(ctx context.Context, accountId int64) error {
 	return as.accountRepo.Delete(ctx, a)
 }
 
+// StartPeriod opens a new period for the account. When currentPeriod is given
+// it is closed and its ending balance carried into the new period.
 func (as *AccountService) StartPeriod(ctx context.Context, accountId int64, startDay uint8, currentPeriod *domain.Period) (*domain.Period, error) {
+	var period domain.Period
+	err := as.uow.Do(ctx, func(r repo.Repos) error {
+		var err error
+		period, err = startPeriod(ctx, r, accountId, startDay, currentPeriod)
+		return err
+	})
+	if err != nil {
+		return currentPeriod, err
+	}
+
+	return &period, nil
+}
+
+// CloseActivePeriod closes the account's active period and opens the next one
+// with the ending balance carried forward. Nothing is written if any step fails.
+func (as *AccountService) CloseActivePeriod(ctx context.Context, accountId int64) (domain.Period, error) {
+	var period domain.Period
+	err := as.uow.Do(ctx, func(r repo.Repos) error {
+		account, err := r.Accounts.Single(ctx, accountId)
+		if err != nil {
+			return fmt.Errorf("close active period: %w", err)
+		}
+
+		current, err := r.Periods.GetPeriod(ctx, accountId, repo.ActivePeriodId)
+		if err != nil {
+			return fmt.Errorf("close active period: %w", err)
+		}
+
+		period, err = startPeriod(ctx, r, accountId, account.PeriodStartDay, &current)
+		return err
+	})
+
+	return period, err
+}
+
+// RollOverPeriods advances every account whose active period ended before now,
+// opening as many periods as needed to reach the one covering now. Each account
+// is rolled over in its own unit of work, so one failing account does not hold
+// back the rest. Returns the periods that were opened along with every
+// account's error joined.
+func (as *AccountService) RollOverPeriods(ctx context.Context, now time.Time) ([]domain.Period, error) {
+	accounts, err := as.accountRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("roll over periods: %w", err)
+	}
+
+	opened := make([]domain.Period, 0, len(accounts))
+	var errs []error
+	for _, account := range accounts {
+		var started []domain.Period
+		err := as.uow.Do(ctx, func(r repo.Repos) error {
+			started = started[:0]
+			current, err := r.Periods.GetPeriod(ctx, account.Id, repo.ActivePeriodId)
+			if err != nil {
+				return fmt.Errorf("get active period for account %d: %w", account.Id, err)
+			}
+
+			for periodEnded(current, now) {
+				current, err = startPeriod(ctx, r, account.Id, account.PeriodStartDay, &current)
+				if err != nil {
+					return fmt.Errorf("roll over account %d: %w", account.Id, err)
+				}
+				started = append(started, current)
+			}
+			return nil
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		opened = append(opened, started...)
+	}
+
+	return opened, errors.Join(errs...)
+}
+
+// periodEnded reports whether now falls after the last day of the period.
+func periodEnded(p domain.Period, now time.Time) bool {
+	end := p.ReportingEnd
+	dayAfterEnd := time.Date(end.Year(), end.Month(), end.Day()+1, 0, 0, 0, 0, time.UTC)
+	return !now.UTC().Before(dayAfterEnd)
+}
+
+func startPeriod(ctx context.Context, r repo.Repos, accountId int64, startDay uint8, currentPeriod *domain.Period) (domain.Period, error) {
 	var openTime time.Time
 	var reportStart time.Time
 	var reportEnd time.Time
@@ -132,42 +228,62 @@ func (as *AccountService) StartPeriod(ctx context.Context, accountId int64, star
 		openTime = time.Now().UTC()
 		reportStart = time.Date(t.Year(), t.Month()+1, int(startDay), 12, 0, 0, 0, time.UTC)
 
-		account, err := as.accountRepo.Single(ctx, accountId)
+		closing, err := r.Periods.GetPeriod(ctx, accountId, currentPeriod.Id)
 		if err != nil {
-			return currentPeriod, fmt.Errorf("get account pre close out %d: %w", accountId, err)
+			return closing, fmt.Errorf("get period pre close out %d: %w", currentPeriod.Id, err)
 		}
 
-		endingBalance = account.ActivePeriod.Balance
+		endingBalance = closing.Balance
 	}
 
 	reportEnd = reportStart.AddDate(0, 1, -1)
 
 	if currentPeriod != nil {
-		// todo: need to make a way to do this in a db transaction
-		err := as.periodRepo.ClosePeriod(ctx, accountId)
+		err := r.Periods.ClosePeriod(ctx, currentPeriod.Id)
 		if err != nil {
-			return currentPeriod, fmt.Errorf("close period for account %d: %w", accountId, err)
+			return *currentPeriod, fmt.Errorf("close period %d for account %d: %w", currentPeriod.Id, accountId, err)
 		}
 	}
 
-	period, err := as.periodRepo.StartPeriod(ctx, accountId, reportStart, reportEnd, openTime)
+	period, err := r.Periods.StartPeriod(ctx, accountId, reportStart, reportEnd, openTime)
 	if err != nil {
-		return &period, fmt.Errorf("start period for account %d: %w", accountId, err)
+		return period, fmt.Errorf("start period for account %d: %w", accountId, err)
 	}
 
-	_, err = as.transactionRepo.Add(ctx, domain.Transaction{
+	_, err = r.Transactions.Add(ctx, domain.Transaction{
 		AccountId: accountId,
 		PeriodId:  period.Id,
 		Name:      "Opening Balance",
 		Amount:    endingBalance,
-		Date:      time.Now().UTC(),
+		Date:      reportStart,
 		CanDelete: false,
 	})
 	if err != nil {
-		return &period, fmt.Errorf("opening transaction: %w", err)
+		return period, fmt.Errorf("opening transaction: %w", err)
 	}
 
-	return &period, nil
+	if currentPeriod != nil {
+		err = r.Budgets.RollOver(ctx, accountId, currentPeriod.Id, period.Id)
+		if err != nil {
+			return period, fmt.Errorf("roll over budgets for account %d: %w", accountId, err)
+		}
+	}
+
+	period.Balance = endingBalance
+
+	period.AutoApplied, err = autoApplyRecurrings(ctx, r, accountId, period.Id)
+	if err != nil {
+		return period, err
+	}
+	for _, t := range period.AutoApplied {
+		period.Balance += t.Amount
+	}
+
+	return period, nil
+}
+
+func (as *AccountService) ListPeriods(ctx context.Context, accountId int64) ([]domain.PeriodSummary, error) {
+	return as.periodRepo.ListPeriods(ctx, accountId)
 }
 
 func (as *AccountService) GetActivePeriod(ctx context.Context, accountId int64) (domain.Period, error) {
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"tjdickerson/sacbooks/internal/domain"
+	"tjdickerson/sacbooks/internal/repo"
+	"tjdickerson/sacbooks/pkg/types"
+)
+
+type BudgetService struct {
+	uow          *repo.UnitOfWork
+	budgetRepo   *repo.BudgetRepo
+	periodRepo   *repo.PeriodRepo
+	categoryRepo *repo.CategoryRepo
+}
+
+func NewBudgetService(uow *repo.UnitOfWork, budgetRepo *repo.BudgetRepo, periodRepo *repo.PeriodRepo, categoryRepo *repo.CategoryRepo) *BudgetService {
+	return &BudgetService{
+		uow:          uow,
+		budgetRepo:   budgetRepo,
+		periodRepo:   periodRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+var (
+	ErrorNoPreviousPeriod = errors.New("no previous period to copy from")
+	ErrorBudgetCategory   = errors.New("category belongs to another account")
+	ErrorBudgetAmount     = errors.New("budget amount can't be negative")
+)
+
+// Set saves the category's budget for the period. A PeriodId of 0 budgets
+// the account's active period.
+func (bs *BudgetService) Set(ctx context.Context, input types.BudgetInput) (domain.Budget, error) {
+	if input.Amount < 0 {
+		return domain.Budget{}, ErrorBudgetAmount
+	}
+
+	category, err := bs.categoryRepo.Single(ctx, input.CategoryId)
+	if err != nil {
+		return domain.Budget{}, err
+	}
+	if category.AccountId != input.AccountId {
+		return domain.Budget{}, fmt.Errorf("%w: category %d", ErrorBudgetCategory, category.Id)
+	}
+
+	period, err := bs.periodRepo.GetPeriod(ctx, input.AccountId, input.PeriodId)
+	if err != nil {
+		return domain.Budget{}, err
+	}
+
+	return bs.budgetRepo.Set(ctx, domain.Budget{
+		AccountId:  input.AccountId,
+		PeriodId:   period.Id,
+		CategoryId: input.CategoryId,
+		Amount:     input.Amount,
+	})
+}
+
+// CopyFromPrevious copies every budget of the period before periodId onto it
+// and returns the period's budget vs actual.
+func (bs *BudgetService) CopyFromPrevious(ctx context.Context, accountId int64, periodId int64) (domain.Period, []domain.BudgetLine, error) {
+	var period domain.Period
+	var lines []domain.BudgetLine
+
+	err := bs.uow.Do(ctx, func(r repo.Repos) error {
+		var err error
+		period, err = r.Periods.GetPeriod(ctx, accountId, periodId)
+		if err != nil {
+			return err
+		}
+
+		previousId, err := r.Periods.PreviousPeriodId(ctx, period.Id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrorNoPreviousPeriod
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := r.Budgets.Copy(ctx, previousId, period.Id); err != nil {
+			return err
+		}
+
+		lines, err = r.Budgets.BudgetVsActual(ctx, accountId, period.Id)
+		return err
+	})
+
+	return period, lines, err
+}
+
+// BudgetVsActual reports every category's budget and spending for the period,
+// or for the active period when periodId is 0.
+func (bs *BudgetService) BudgetVsActual(ctx context.Context, accountId int64, periodId int64) (domain.Period, []domain.BudgetLine, error) {
+	period, err := bs.periodRepo.GetPeriod(ctx, accountId, periodId)
+	if err != nil {
+		return period, nil, err
+	}
+
+	lines, err := bs.budgetRepo.BudgetVsActual(ctx, accountId, period.Id)
+	return period, lines, err
+}
+
+// RolloverHistory lists what the category carried into each period, newest
+// first.
+func (bs *BudgetService) RolloverHistory(ctx context.Context, categoryId int64) ([]domain.BudgetRollover, error) {
+	return bs.budgetRepo.RolloverHistory(ctx, categoryId)
+}
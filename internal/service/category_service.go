@@ -2,12 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"tjdickerson/sacbooks/internal/domain"
 	"tjdickerson/sacbooks/internal/repo"
 	"tjdickerson/sacbooks/pkg/types"
 )
 
+var ErrorInvalidCategoryKind = errors.New("invalid category kind")
+
 type CategoryService struct {
 	categoryRepo *repo.CategoryRepo
 }
@@ -26,16 +29,32 @@ func (cs *CategoryService) Single(ctx context.Context, categoryId int64) (domain
 	return cs.categoryRepo.Single(ctx, categoryId)
 }
 
+// Totals sums the period's spending and income by category with split
+// transactions divided between their lines' categories.
+func (cs *CategoryService) Totals(ctx context.Context, accountId int64, periodId int64) ([]domain.CategoryTotal, error) {
+	return cs.categoryRepo.Totals(ctx, accountId, periodId)
+}
+
 func (cs *CategoryService) Add(ctx context.Context, accountId int64, input types.CategoryInsertInput) (domain.Category, error) {
+	if err := validateCategoryKind(input.Kind); err != nil {
+		return domain.Category{}, err
+	}
+
 	c := domain.Category{
 		AccountId: accountId,
 		Name:      input.Name,
 		Color:     input.Color,
+		Rollover:  input.Rollover != nil && *input.Rollover,
+		Kind:      input.Kind,
 	}
 	return cs.categoryRepo.Add(ctx, c)
 }
 
 func (cs *CategoryService) Update(ctx context.Context, accountId int64, input types.CategoryUpdateInput) (domain.Category, error) {
+	if err := validateCategoryKind(input.Kind); err != nil {
+		return domain.Category{}, err
+	}
+
 	c, err := cs.categoryRepo.Single(ctx, input.Id)
 	if err != nil {
 		return c, fmt.Errorf("unable to get category %d: %w", input.Id, err)
@@ -47,15 +66,32 @@ func (cs *CategoryService) Update(ctx context.Context, accountId int64, input ty
 
 	c.Name = input.Name
 	c.Color = input.Color
+	if input.Rollover != nil {
+		c.Rollover = *input.Rollover
+	}
+	if input.Kind != "" {
+		c.Kind = input.Kind
+	}
 
 	c, err = cs.categoryRepo.Update(ctx, c)
 	if err != nil {
-		return c, fmt.Errorf("unable to update category %s: %w", input.Id, err)
+		return c, fmt.Errorf("unable to update category %d: %w", input.Id, err)
 	}
 
 	return c, nil
 }
 
+// validateCategoryKind accepts the known kinds and "", which leaves a new
+// category auto and an existing one unchanged.
+func validateCategoryKind(kind string) error {
+	switch kind {
+	case "", domain.CategoryKindAuto, domain.CategoryKindIncome, domain.CategoryKindExpense:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrorInvalidCategoryKind, kind)
+	}
+}
+
 func (cs *CategoryService) Delete(ctx context.Context, categoryId int64) error {
 	return cs.categoryRepo.Delete(ctx, categoryId)
 }
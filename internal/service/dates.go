@@ -0,0 +1,18 @@
+package service
+
+import "time"
+
+// dayRange converts millisecond bounds into the start of the first day and the
+// end of the last day. A bound of 0 stays open.
+func dayRange(startMillis int64, endMillis int64) (time.Time, time.Time) {
+	var start, end time.Time
+	if startMillis != 0 {
+		t := time.UnixMilli(startMillis).UTC()
+		start = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	if endMillis != 0 {
+		t := time.UnixMilli(endMillis).UTC()
+		end = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	}
+	return start, end
+}
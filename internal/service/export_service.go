@@ -0,0 +1,212 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"tjdickerson/sacbooks/internal/domain"
+	"tjdickerson/sacbooks/internal/exporter"
+	"tjdickerson/sacbooks/internal/repo"
+	"tjdickerson/sacbooks/pkg/types"
+)
+
+type ExportService struct {
+	accountRepo     *repo.AccountRepo
+	transactionRepo *repo.TransactionRepo
+	splitRepo       *repo.TransactionSplitRepo
+	transferRepo    *repo.TransferRepo
+	categoryRepo    *repo.CategoryRepo
+}
+
+func NewExportService(accountRepo *repo.AccountRepo, transactionRepo *repo.TransactionRepo, splitRepo *repo.TransactionSplitRepo, transferRepo *repo.TransferRepo, categoryRepo *repo.CategoryRepo) *ExportService {
+	return &ExportService{
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		splitRepo:       splitRepo,
+		transferRepo:    transferRepo,
+		categoryRepo:    categoryRepo,
+	}
+}
+
+// ExportQIF writes the account's transactions for the period range as QIF.
+// Only the first period's opening balance is written since every later one
+// is carried from transactions already in the file.
+func (es *ExportService) ExportQIF(ctx context.Context, input types.PeriodRangeInput) (domain.ExportFile, error) {
+	var file domain.ExportFile
+
+	account, err := es.accountRepo.Single(ctx, input.AccountId)
+	if err != nil {
+		return file, fmt.Errorf("export qif: %w", err)
+	}
+
+	transactions, err := es.listWithSplits(ctx, input.AccountId, input.FromPeriodId, input.ToPeriodId)
+	if err != nil {
+		return file, fmt.Errorf("export qif: %w", err)
+	}
+
+	categoryNames, err := es.categoryNames(ctx, input.AccountId)
+	if err != nil {
+		return file, fmt.Errorf("export qif: %w", err)
+	}
+
+	transferAccounts, err := es.transferAccounts(ctx, input.AccountId)
+	if err != nil {
+		return file, fmt.Errorf("export qif: %w", err)
+	}
+
+	var buf bytes.Buffer
+	err = exporter.WriteQIF(&buf, account, firstOpeningBalanceOnly(transactions), categoryNames, transferAccounts)
+	if err != nil {
+		return file, err
+	}
+
+	file.FileName = exportFileName(account, "qif")
+	file.Content = buf.String()
+	return file, nil
+}
+
+var ErrorUnknownJournalFormat = errors.New("unknown journal format")
+
+// ExportJournal renders the chosen account, or every account when AccountId is
+// 0, as a ledger, hledger or beancount journal limited to the date range.
+func (es *ExportService) ExportJournal(ctx context.Context, input types.JournalExportInput) (domain.ExportFile, error) {
+	var file domain.ExportFile
+
+	var accounts []domain.Account
+	if input.AccountId == 0 {
+		list, err := es.accountRepo.List(ctx)
+		if err != nil {
+			return file, fmt.Errorf("export journal: %w", err)
+		}
+		accounts = list
+	} else {
+		a, err := es.accountRepo.Single(ctx, input.AccountId)
+		if err != nil {
+			return file, fmt.Errorf("export journal: %w", err)
+		}
+		accounts = []domain.Account{a}
+	}
+
+	categories := make(map[int64]domain.Category)
+	journalAccounts := make([]exporter.JournalAccount, 0, len(accounts))
+	for _, a := range accounts {
+		list, err := es.categoryRepo.List(ctx, a.Id)
+		if err != nil {
+			return file, fmt.Errorf("export journal: %w", err)
+		}
+		for _, c := range list {
+			categories[c.Id] = c
+		}
+
+		transactions, err := es.listWithSplits(ctx, a.Id, 0, 0)
+		if err != nil {
+			return file, fmt.Errorf("export journal: %w", err)
+		}
+		journalAccounts = append(journalAccounts, exporter.JournalAccount{Account: a, Transactions: transactions})
+	}
+
+	start, end := dayRange(input.StartDate, input.EndDate)
+	entries := exporter.BuildJournal(journalAccounts, categories, start, end)
+
+	var buf bytes.Buffer
+	var err error
+	switch input.Format {
+	case "ledger", "hledger":
+		err = exporter.WriteLedger(&buf, entries)
+		file.FileName = "sacbooks." + input.Format
+	case "beancount":
+		err = exporter.WriteBeancount(&buf, entries)
+		file.FileName = "sacbooks.beancount"
+	default:
+		return file, fmt.Errorf("%w: %q", ErrorUnknownJournalFormat, input.Format)
+	}
+	if err != nil {
+		return file, err
+	}
+
+	file.Content = buf.String()
+	return file, nil
+}
+
+// listWithSplits lists the account's transactions for the period range with
+// their splits filled in.
+func (es *ExportService) listWithSplits(ctx context.Context, accountId int64, fromPeriodId int64, toPeriodId int64) ([]domain.Transaction, error) {
+	transactions, err := es.transactionRepo.ListForPeriods(ctx, accountId, fromPeriodId, toPeriodId)
+	if err != nil {
+		return nil, err
+	}
+
+	splits, err := es.splitRepo.ListForAccount(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range transactions {
+		transactions[i].Splits = splits[transactions[i].Id]
+	}
+	return transactions, nil
+}
+
+func (es *ExportService) categoryNames(ctx context.Context, accountId int64) (map[int64]string, error) {
+	categories, err := es.categoryRepo.List(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[int64]string, len(categories))
+	for _, c := range categories {
+		names[c.Id] = c.Name
+	}
+	return names, nil
+}
+
+// transferAccounts names the account on the other side of each of the
+// account's transfers, keyed by transfer id.
+func (es *ExportService) transferAccounts(ctx context.Context, accountId int64) (map[int64]string, error) {
+	transfers, err := es.transferRepo.List(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := es.accountRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[int64]string, len(accounts))
+	for _, a := range accounts {
+		names[a.Id] = a.Name
+	}
+
+	others := make(map[int64]string, len(transfers))
+	for id, t := range transfers {
+		if t.FromAccountId == accountId {
+			others[id] = names[t.ToAccountId]
+		} else {
+			others[id] = names[t.FromAccountId]
+		}
+	}
+	return others, nil
+}
+
+func firstOpeningBalanceOnly(transactions []domain.Transaction) []domain.Transaction {
+	out := make([]domain.Transaction, 0, len(transactions))
+	seenOpening := false
+	for _, t := range transactions {
+		if !t.CanDelete {
+			if seenOpening {
+				continue
+			}
+			seenOpening = true
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+func exportFileName(account domain.Account, extension string) string {
+	name := strings.ToLower(strings.Join(strings.Fields(account.Name), "-"))
+	return fmt.Sprintf("%s.%s", name, extension)
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+	"tjdickerson/sacbooks/internal/repo"
+)
+
+// DefaultForecastDays and MaxForecastDays bound how far ahead a forecast
+// looks when the caller does not say or asks for too much.
+const (
+	DefaultForecastDays = 90
+	MaxForecastDays     = 730
+)
+
+var ErrorInvalidHorizon = errors.New("invalid forecast horizon")
+
+type ForecastService struct {
+	uow *repo.UnitOfWork
+}
+
+func NewForecastService(uow *repo.UnitOfWork) *ForecastService {
+	return &ForecastService{uow: uow}
+}
+
+// Forecast projects the account's balance from today for the given number of
+// days. It starts from the active period's balance and applies each pending
+// recurring occurrence of the active period on its scheduled day, then every
+// occurrence that falls after the period. Occurrences whose day has already
+// passed without being applied are expected today.
+func (fs *ForecastService) Forecast(ctx context.Context, accountId int64, days int, now time.Time) (domain.Forecast, error) {
+	forecast := domain.Forecast{AccountId: accountId}
+
+	if days < 0 || days > MaxForecastDays {
+		return forecast, fmt.Errorf("%w: %d days", ErrorInvalidHorizon, days)
+	}
+	if days == 0 {
+		days = DefaultForecastDays
+	}
+
+	start := domain.Noon(now)
+	end := start.AddDate(0, 0, days)
+	events := make(map[time.Time][]domain.ForecastEvent)
+
+	err := fs.uow.Do(ctx, func(r repo.Repos) error {
+		period, err := r.Periods.GetPeriod(ctx, accountId, repo.ActivePeriodId)
+		if err != nil {
+			return fmt.Errorf("get active period: %w", err)
+		}
+		forecast.StartBalance = period.Balance
+
+		recurrings, err := r.Recurrings.List(ctx, accountId, period.Id)
+		if err != nil {
+			return err
+		}
+
+		afterPeriod := domain.Noon(period.ReportingEnd).AddDate(0, 0, 1)
+		for _, rt := range recurrings {
+			event := domain.ForecastEvent{RecurringId: rt.Id, Name: rt.Name, Amount: rt.Amount}
+
+			for _, o := range rt.Occurrences {
+				if o.Actualized() {
+					continue
+				}
+				day := later(o.Date, start)
+				if !day.After(end) {
+					events[day] = append(events[day], event)
+				}
+			}
+
+			for _, d := range rt.OccurrencesBetween(later(afterPeriod, start), end) {
+				events[d] = append(events[d], event)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return forecast, fmt.Errorf("forecast account %d: %w", accountId, err)
+	}
+
+	balance := forecast.StartBalance
+	forecast.LowestBalance = balance
+	forecast.LowestDate = start
+	forecast.Days = make([]domain.ForecastDay, 0, days+1)
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		day := domain.ForecastDay{Date: d, Events: events[d]}
+		for _, e := range day.Events {
+			balance += e.Amount
+		}
+		day.Balance = balance
+
+		if balance < forecast.LowestBalance {
+			forecast.LowestBalance = balance
+			forecast.LowestDate = d
+		}
+		forecast.Days = append(forecast.Days, day)
+	}
+
+	return forecast, nil
+}
+
+func later(a time.Time, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,426 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"tjdickerson/sacbooks/internal/domain"
+	"tjdickerson/sacbooks/internal/importer"
+	"tjdickerson/sacbooks/internal/repo"
+	"tjdickerson/sacbooks/pkg/types"
+)
+
+var ErrorImportHasInvalidRows = errors.New("import has invalid rows")
+var ErrorImportIntoClosedPeriod = errors.New("period is closed")
+
+type ImportService struct {
+	uow               *repo.UnitOfWork
+	importProfileRepo *repo.ImportProfileRepo
+	periodRepo        *repo.PeriodRepo
+	transactionRepo   *repo.TransactionRepo
+	categoryRepo      *repo.CategoryRepo
+}
+
+func NewImportService(
+	uow *repo.UnitOfWork,
+	importProfileRepo *repo.ImportProfileRepo,
+	periodRepo *repo.PeriodRepo,
+	transactionRepo *repo.TransactionRepo,
+	categoryRepo *repo.CategoryRepo) *ImportService {
+	return &ImportService{
+		uow:               uow,
+		importProfileRepo: importProfileRepo,
+		periodRepo:        periodRepo,
+		transactionRepo:   transactionRepo,
+		categoryRepo:      categoryRepo,
+	}
+}
+
+func (is *ImportService) ListProfiles(ctx context.Context, accountId int64) ([]domain.ImportProfile, error) {
+	return is.importProfileRepo.List(ctx, accountId)
+}
+
+func (is *ImportService) AddProfile(ctx context.Context, accountId int64, input types.ImportProfileInput) (domain.ImportProfile, error) {
+	p := applyProfileInput(domain.ImportProfile{AccountId: accountId}, input)
+	if err := importer.ValidateProfile(p); err != nil {
+		return p, err
+	}
+
+	return is.importProfileRepo.Add(ctx, p)
+}
+
+func (is *ImportService) UpdateProfile(ctx context.Context, accountId int64, input types.ImportProfileInput) (domain.ImportProfile, error) {
+	p, err := is.importProfileRepo.Single(ctx, input.Id)
+	if err != nil {
+		return p, fmt.Errorf("update import profile %d: %w", input.Id, err)
+	}
+
+	if p.AccountId != accountId {
+		return p, fmt.Errorf("invalid account id %d for import profile %d", accountId, p.Id)
+	}
+
+	p = applyProfileInput(p, input)
+	if err := importer.ValidateProfile(p); err != nil {
+		return p, err
+	}
+
+	return is.importProfileRepo.Update(ctx, p)
+}
+
+func (is *ImportService) DeleteProfile(ctx context.Context, profileId int64) error {
+	return is.importProfileRepo.Delete(ctx, profileId)
+}
+
+// PreviewCSV parses the file with the chosen profile and assigns each row to
+// the account period covering its date without writing anything.
+func (is *ImportService) PreviewCSV(ctx context.Context, input types.CSVImportInput) ([]domain.ImportRow, error) {
+	p, err := is.importProfileRepo.Single(ctx, input.ProfileId)
+	if err != nil {
+		return nil, fmt.Errorf("preview csv: %w", err)
+	}
+
+	if p.AccountId != input.AccountId {
+		return nil, fmt.Errorf("invalid account id %d for import profile %d", input.AccountId, p.Id)
+	}
+
+	rows, err := importer.ParseCSV(strings.NewReader(input.Content), p)
+	if err != nil {
+		return rows, fmt.Errorf("preview csv: %w", err)
+	}
+
+	return assignPeriods(ctx, is.periodRepo, rows, input.AccountId, input.CategoryId), nil
+}
+
+// ImportCSV inserts every previewed row in one batch. Nothing is written if
+// any row failed to parse or falls outside the account's periods.
+func (is *ImportService) ImportCSV(ctx context.Context, input types.CSVImportInput) ([]domain.Transaction, error) {
+	rows, err := is.PreviewCSV(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := domain.ImportBatch{AccountId: input.AccountId, Source: "csv", FileName: input.FileName}
+	return insertImportRows(ctx, is.uow, batch, rows)
+}
+
+// PreviewOFX parses an OFX or QFX statement and flags entries whose FITID was
+// already imported into the account.
+func (is *ImportService) PreviewOFX(ctx context.Context, input types.FileImportInput) ([]domain.ImportRow, error) {
+	rows, err := importer.ParseOFX(strings.NewReader(input.Content))
+	if err != nil {
+		return rows, fmt.Errorf("preview ofx: %w", err)
+	}
+
+	rows = assignPeriods(ctx, is.periodRepo, rows, input.AccountId, input.CategoryId)
+	return markDuplicates(ctx, is.transactionRepo, rows, input.AccountId)
+}
+
+// ImportOFX inserts every entry that hasn't been imported before in one batch,
+// so re-importing an overlapping date range never double counts.
+func (is *ImportService) ImportOFX(ctx context.Context, input types.FileImportInput) ([]domain.Transaction, error) {
+	rows, err := is.PreviewOFX(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := domain.ImportBatch{AccountId: input.AccountId, Source: "ofx", FileName: input.FileName}
+	return insertImportRows(ctx, is.uow, batch, rows)
+}
+
+// PreviewQIF parses a QIF file and matches each entry's category by name to
+// the account's categories. Unmatched categories are created on import.
+func (is *ImportService) PreviewQIF(ctx context.Context, input types.QIFImportInput) ([]domain.ImportRow, error) {
+	rows, err := importer.ParseQIF(strings.NewReader(input.Content), input.DateFormat)
+	if err != nil {
+		return rows, fmt.Errorf("preview qif: %w", err)
+	}
+
+	rows = assignPeriods(ctx, is.periodRepo, rows, input.AccountId, input.CategoryId)
+
+	categories, err := is.categoryRepo.List(ctx, input.AccountId)
+	if err != nil {
+		return rows, fmt.Errorf("preview qif: %w", err)
+	}
+
+	rows = assignCategories(rows, categories)
+	return resolveSplits(rows, categoryIds(categories), input.CategoryId, newCategoryId), nil
+}
+
+// ImportQIF creates any missing categories and inserts every entry in one batch.
+func (is *ImportService) ImportQIF(ctx context.Context, input types.QIFImportInput) ([]domain.Transaction, error) {
+	rows, err := is.PreviewQIF(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkImportRows(rows); err != nil {
+		return nil, err
+	}
+
+	batch := domain.ImportBatch{AccountId: input.AccountId, Source: "qif", FileName: input.FileName}
+	var inserted []domain.Transaction
+	err = is.uow.Do(ctx, func(r repo.Repos) error {
+		categories, err := r.Categories.List(ctx, input.AccountId)
+		if err != nil {
+			return fmt.Errorf("import qif: %w", err)
+		}
+
+		ids := categoryIds(categories)
+		category := func(name string) (int64, error) {
+			id, ok := ids[strings.ToLower(name)]
+			if ok {
+				return id, nil
+			}
+
+			c, err := r.Categories.Add(ctx, domain.Category{AccountId: input.AccountId, Name: name, Color: "#cacaca"})
+			if err != nil {
+				return 0, fmt.Errorf("create category %s: %w", name, err)
+			}
+			ids[strings.ToLower(name)] = c.Id
+			return c.Id, nil
+		}
+
+		for i := range rows {
+			if name := rows[i].CategoryName; name != "" {
+				rows[i].Transaction.CategoryId, err = category(name)
+				if err != nil {
+					return err
+				}
+			}
+			for _, s := range rows[i].Splits {
+				if s.CategoryName != "" {
+					if _, err := category(s.CategoryName); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		rows = resolveSplits(rows, ids, input.CategoryId, 0)
+		if err := checkImportRows(rows); err != nil {
+			return err
+		}
+
+		inserted, err = addImportRows(ctx, r, batch, rows)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return inserted, nil
+}
+
+// newCategoryId stands in for a category that ImportQIF will create, so split
+// lines naming one still pass validation in a preview.
+const newCategoryId = -1
+
+func categoryIds(categories []domain.Category) map[string]int64 {
+	ids := make(map[string]int64, len(categories))
+	for _, c := range categories {
+		ids[strings.ToLower(c.Name)] = c.Id
+	}
+	return ids
+}
+
+// resolveSplits turns each split row's lines into Transaction.Splits, checked
+// the same way as splits entered by hand. Lines without a category take
+// defaultCategoryId and lines naming an unknown category take missingId.
+func resolveSplits(rows []domain.ImportRow, ids map[string]int64, defaultCategoryId int64, missingId int64) []domain.ImportRow {
+	for i := range rows {
+		if rows[i].Err != nil || len(rows[i].Splits) == 0 {
+			continue
+		}
+
+		lines := make([]types.TransactionSplitInput, 0, len(rows[i].Splits))
+		for _, s := range rows[i].Splits {
+			id := defaultCategoryId
+			if s.CategoryName != "" {
+				known, ok := ids[strings.ToLower(s.CategoryName)]
+				id = missingId
+				if ok {
+					id = known
+				}
+			}
+			lines = append(lines, types.TransactionSplitInput{CategoryId: id, Amount: s.Amount, Memo: s.Memo})
+		}
+
+		rows[i].Transaction.Splits, rows[i].Err = splitsFromInput(rows[i].Transaction.Amount, lines)
+	}
+
+	return rows
+}
+
+// assignCategories sets the category of rows whose CategoryName matches an
+// existing category, ignoring case.
+func assignCategories(rows []domain.ImportRow, categories []domain.Category) []domain.ImportRow {
+	ids := make(map[string]int64, len(categories))
+	for _, c := range categories {
+		ids[strings.ToLower(c.Name)] = c.Id
+	}
+
+	for i := range rows {
+		if id, ok := ids[strings.ToLower(rows[i].CategoryName)]; ok {
+			rows[i].Transaction.CategoryId = id
+		}
+	}
+
+	return rows
+}
+
+// assignPeriods files each row under the open period covering its date. Rows
+// dated in a closed period are rejected since its ending balance has already
+// been carried into the next period's opening balance. Opening balance rows
+// are not filed under a period.
+func assignPeriods(ctx context.Context, periodRepo *repo.PeriodRepo, rows []domain.ImportRow, accountId int64, categoryId int64) []domain.ImportRow {
+	for i := range rows {
+		if rows[i].Err != nil {
+			continue
+		}
+
+		t := &rows[i].Transaction
+		t.AccountId = accountId
+		t.CategoryId = categoryId
+		t.CanDelete = true
+		if rows[i].OpeningBalance {
+			continue
+		}
+
+		period, err := periodRepo.PeriodForDate(ctx, accountId, t.Date)
+		if err != nil {
+			rows[i].Err = fmt.Errorf("no period for %s: %w", t.Date.Format("Jan 02 2006"), err)
+			continue
+		}
+
+		if !period.ClosedOn.IsZero() {
+			rows[i].Err = fmt.Errorf("%s: %w", t.Date.Format("Jan 02 2006"), ErrorImportIntoClosedPeriod)
+			continue
+		}
+		t.PeriodId = period.Id
+	}
+
+	return rows
+}
+
+// markDuplicates flags rows whose external id is already stored for the
+// account or appears earlier in the same file.
+func markDuplicates(ctx context.Context, transactionRepo *repo.TransactionRepo, rows []domain.ImportRow, accountId int64) ([]domain.ImportRow, error) {
+	seen := make(map[string]bool, len(rows))
+	for i := range rows {
+		externalId := rows[i].Transaction.ExternalId
+		if externalId == "" {
+			continue
+		}
+
+		if seen[externalId] {
+			rows[i].Duplicate = true
+			continue
+		}
+		seen[externalId] = true
+
+		exists, err := transactionRepo.ExternalIdExists(ctx, accountId, externalId)
+		if err != nil {
+			return rows, fmt.Errorf("check duplicate line %d: %w", rows[i].Line, err)
+		}
+		rows[i].Duplicate = exists
+	}
+
+	return rows, nil
+}
+
+func checkImportRows(rows []domain.ImportRow) error {
+	for _, row := range rows {
+		if row.Err != nil && !row.Duplicate {
+			return fmt.Errorf("%w: line %d: %s", ErrorImportHasInvalidRows, row.Line, row.Err)
+		}
+	}
+	return nil
+}
+
+func insertImportRows(ctx context.Context, uow *repo.UnitOfWork, batch domain.ImportBatch, rows []domain.ImportRow) ([]domain.Transaction, error) {
+	if err := checkImportRows(rows); err != nil {
+		return nil, err
+	}
+
+	var inserted []domain.Transaction
+	err := uow.Do(ctx, func(r repo.Repos) error {
+		var err error
+		inserted, err = addImportRows(ctx, r, batch, rows)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return inserted, nil
+}
+
+// addImportRows records the batch and inserts every row that isn't a
+// duplicate. Opening balance rows adjust the account's opening balances
+// instead of being inserted. No batch is recorded when there is nothing to
+// insert.
+func addImportRows(ctx context.Context, r repo.Repos, batch domain.ImportBatch, rows []domain.ImportRow) ([]domain.Transaction, error) {
+	inserted := make([]domain.Transaction, 0, len(rows))
+	count := 0
+	for _, row := range rows {
+		if row.OpeningBalance {
+			err := r.Transactions.AdjustOpeningBalances(ctx, batch.AccountId, row.Transaction.Amount)
+			if err != nil {
+				return nil, fmt.Errorf("import line %d: %w", row.Line, err)
+			}
+			continue
+		}
+		if !row.Duplicate {
+			count++
+		}
+	}
+	if count == 0 {
+		return inserted, nil
+	}
+
+	batch, err := r.Batches.Add(ctx, batch)
+	if err != nil {
+		return nil, fmt.Errorf("import batch: %w", err)
+	}
+
+	for _, row := range rows {
+		if row.Duplicate || row.OpeningBalance {
+			continue
+		}
+
+		row.Transaction.ImportBatchId = batch.Id
+		if row.Transaction.CategoryId == 0 && len(row.Transaction.Splits) > 0 {
+			row.Transaction.CategoryId = row.Transaction.Splits[0].CategoryId
+		}
+
+		t, err := r.Transactions.Add(ctx, row.Transaction)
+		if err != nil {
+			return nil, fmt.Errorf("import line %d: %w", row.Line, err)
+		}
+
+		if len(row.Transaction.Splits) > 0 {
+			t.Splits, err = r.Splits.Replace(ctx, t.Id, row.Transaction.Splits)
+			if err != nil {
+				return nil, fmt.Errorf("import line %d: %w", row.Line, err)
+			}
+		}
+		inserted = append(inserted, t)
+	}
+
+	return inserted, nil
+}
+
+func applyProfileInput(p domain.ImportProfile, input types.ImportProfileInput) domain.ImportProfile {
+	p.Name = input.Name
+	p.Delimiter = input.Delimiter
+	p.DateFormat = input.DateFormat
+	p.HeaderRows = input.HeaderRows
+	p.DateColumn = input.DateColumn
+	p.NameColumn = input.NameColumn
+	p.AmountColumn = input.AmountColumn
+	p.DebitColumn = input.DebitColumn
+	p.CreditColumn = input.CreditColumn
+	p.NegateAmounts = input.NegateAmounts
+	return p
+}
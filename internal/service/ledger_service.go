@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+	"tjdickerson/sacbooks/internal/ledger"
+	"tjdickerson/sacbooks/internal/repo"
+)
+
+var ErrorLedgerNotEmpty = errors.New("database already has data")
+
+type LedgerService struct {
+	uow *repo.UnitOfWork
+}
+
+func NewLedgerService(uow *repo.UnitOfWork) *LedgerService {
+	return &LedgerService{uow: uow}
+}
+
+// Export writes the whole database as a versioned JSON document.
+func (ls *LedgerService) Export(ctx context.Context) (domain.ExportFile, error) {
+	var file domain.ExportFile
+	var doc ledger.Document
+
+	err := ls.uow.Do(ctx, func(r repo.Repos) error {
+		var err error
+		doc, err = r.Ledger.Dump(ctx)
+		return err
+	})
+	if err != nil {
+		return file, fmt.Errorf("export ledger: %w", err)
+	}
+
+	now := time.Now().UTC()
+	doc.Version = ledger.FormatVersion
+	doc.ExportedOn = now.UnixMilli()
+
+	content, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return file, fmt.Errorf("marshal ledger: %w", err)
+	}
+
+	file.FileName = fmt.Sprintf("sacbooks-ledger-%s.json", now.Format("20060102"))
+	file.Content = string(content)
+	return file, nil
+}
+
+// Import restores a ledger document after verifying its references. The
+// database must not hold anything beyond what is created on first start;
+// that default data is replaced by the document.
+func (ls *LedgerService) Import(ctx context.Context, content string) error {
+	var doc ledger.Document
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		return fmt.Errorf("read ledger: %w", err)
+	}
+
+	if err := ledger.Verify(doc); err != nil {
+		return err
+	}
+
+	return ls.uow.Do(ctx, func(r repo.Repos) error {
+		pristine, err := r.Ledger.IsPristine(ctx)
+		if err != nil {
+			return fmt.Errorf("import ledger: %w", err)
+		}
+		if !pristine {
+			return ErrorLedgerNotEmpty
+		}
+
+		if err := r.Ledger.Clear(ctx); err != nil {
+			return fmt.Errorf("import ledger: %w", err)
+		}
+
+		if err := r.Ledger.Restore(ctx, doc); err != nil {
+			return fmt.Errorf("import ledger: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"tjdickerson/sacbooks/internal/domain"
+	"tjdickerson/sacbooks/internal/repo"
+	"tjdickerson/sacbooks/pkg/types"
+)
+
+type ReconciliationService struct {
+	uow *repo.UnitOfWork
+}
+
+func NewReconciliationService(uow *repo.UnitOfWork) *ReconciliationService {
+	return &ReconciliationService{uow: uow}
+}
+
+var (
+	ErrorReconciliationOpen       = errors.New("account already has a reconciliation in progress")
+	ErrorReconciliationFinalized  = errors.New("reconciliation is already finalized")
+	ErrorReconciliationUnbalanced = errors.New("cleared balance does not match the statement")
+	ErrorReconciliationAccount    = errors.New("transaction belongs to another account")
+)
+
+// Start opens a reconciliation against a statement ending on StatementDate.
+// An account can only have one reconciliation in progress at a time.
+func (rs *ReconciliationService) Start(ctx context.Context, input types.ReconciliationInput) (domain.Reconciliation, error) {
+	var rec domain.Reconciliation
+	_, statementEnd := dayRange(0, input.StatementDate)
+
+	err := rs.uow.Do(ctx, func(r repo.Repos) error {
+		_, err := r.Reconciliations.Open(ctx, input.AccountId)
+		if err == nil {
+			return ErrorReconciliationOpen
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		rec, err = r.Reconciliations.Add(ctx, domain.Reconciliation{
+			AccountId:        input.AccountId,
+			StatementDate:    statementEnd,
+			StatementBalance: input.StatementBalance,
+		})
+		if err != nil {
+			return err
+		}
+
+		rec, err = withProgress(ctx, r, rec)
+		return err
+	})
+
+	return rec, err
+}
+
+// Open returns the account's reconciliation in progress.
+func (rs *ReconciliationService) Open(ctx context.Context, accountId int64) (domain.Reconciliation, error) {
+	var rec domain.Reconciliation
+	err := rs.uow.Do(ctx, func(r repo.Repos) error {
+		var err error
+		rec, err = r.Reconciliations.Open(ctx, accountId)
+		if err != nil {
+			return err
+		}
+
+		rec, err = withProgress(ctx, r, rec)
+		return err
+	})
+
+	return rec, err
+}
+
+// SetCleared ticks a transaction on or off the statement and returns the
+// reconciliation with its difference updated.
+func (rs *ReconciliationService) SetCleared(ctx context.Context, reconciliationId int64, transactionId int64, cleared bool) (domain.Reconciliation, error) {
+	var rec domain.Reconciliation
+	err := rs.uow.Do(ctx, func(r repo.Repos) error {
+		var err error
+		rec, err = openReconciliation(ctx, r, reconciliationId)
+		if err != nil {
+			return err
+		}
+
+		t, err := r.Transactions.Single(ctx, transactionId)
+		if err != nil {
+			return err
+		}
+		if t.AccountId != rec.AccountId {
+			return fmt.Errorf("%w: transaction %d", ErrorReconciliationAccount, transactionId)
+		}
+
+		status := domain.StatusUncleared
+		if cleared {
+			status = domain.StatusCleared
+		}
+		if _, err := r.Transactions.SetStatus(ctx, transactionId, status); err != nil {
+			return err
+		}
+
+		rec, err = withProgress(ctx, r, rec)
+		return err
+	})
+
+	return rec, err
+}
+
+// Finalize reconciles every cleared transaction up to the statement date,
+// locking them against edits and deletion. The cleared balance must match the
+// statement balance exactly.
+func (rs *ReconciliationService) Finalize(ctx context.Context, reconciliationId int64) (domain.Reconciliation, error) {
+	var rec domain.Reconciliation
+	err := rs.uow.Do(ctx, func(r repo.Repos) error {
+		var err error
+		rec, err = openReconciliation(ctx, r, reconciliationId)
+		if err != nil {
+			return err
+		}
+
+		rec, err = withProgress(ctx, r, rec)
+		if err != nil {
+			return err
+		}
+		if rec.Difference != 0 {
+			return fmt.Errorf("%w: off by %d", ErrorReconciliationUnbalanced, rec.Difference)
+		}
+
+		finalized, err := r.Reconciliations.Finalize(ctx, rec)
+		if err != nil {
+			return err
+		}
+
+		finalized.ClearedBalance = rec.ClearedBalance
+		rec = finalized
+		return nil
+	})
+
+	return rec, err
+}
+
+// Cancel drops a reconciliation in progress. Transactions keep their cleared
+// marks.
+func (rs *ReconciliationService) Cancel(ctx context.Context, reconciliationId int64) error {
+	return rs.uow.Do(ctx, func(r repo.Repos) error {
+		if _, err := openReconciliation(ctx, r, reconciliationId); err != nil {
+			return err
+		}
+		return r.Reconciliations.Delete(ctx, reconciliationId)
+	})
+}
+
+func openReconciliation(ctx context.Context, r repo.Repos, reconciliationId int64) (domain.Reconciliation, error) {
+	rec, err := r.Reconciliations.Single(ctx, reconciliationId)
+	if err != nil {
+		return rec, err
+	}
+	if !rec.FinalizedOn.IsZero() {
+		return rec, fmt.Errorf("%w: %d", ErrorReconciliationFinalized, reconciliationId)
+	}
+	return rec, nil
+}
+
+// withProgress fills in the cleared balance, the difference from the
+// statement and the transactions still to tick off.
+func withProgress(ctx context.Context, r repo.Repos, rec domain.Reconciliation) (domain.Reconciliation, error) {
+	cleared, err := r.Reconciliations.ClearedBalance(ctx, rec.AccountId, rec.StatementDate)
+	if err != nil {
+		return rec, err
+	}
+
+	candidates, err := r.Reconciliations.Candidates(ctx, rec.AccountId, rec.StatementDate)
+	if err != nil {
+		return rec, err
+	}
+
+	rec.ClearedBalance = cleared
+	rec.Difference = rec.StatementBalance - cleared
+	rec.Transactions = candidates
+	return rec, nil
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 	"tjdickerson/sacbooks/internal/domain"
 	"tjdickerson/sacbooks/internal/repo"
 	"tjdickerson/sacbooks/pkg/types"
@@ -25,14 +27,50 @@ func (rs *RecurringService) Add(ctx context.Context, accountId int64, name strin
 		Name:       name,
 		Amount:     amount,
 		Day:        day,
+		Frequency:  domain.FrequencyMonthly,
+		Interval:   1,
 	}
 	return rs.recurringRepo.Add(ctx, temp)
 }
 
+// AddRule adds a recurring with a full recurrence rule. The anchor date
+// defaults to today and the weekday to the anchor's.
+func (rs *RecurringService) AddRule(ctx context.Context, accountId int64, input types.RecurringInput) (domain.Recurring, error) {
+	anchor := time.Now().UTC()
+	if input.AnchorDate != 0 {
+		anchor = time.UnixMilli(input.AnchorDate).UTC()
+	}
+
+	rt := domain.Recurring{
+		AccountId:  accountId,
+		CategoryId: input.CategoryId,
+		Name:       input.Name,
+		Amount:     input.Amount,
+		Day:        input.Day,
+		Interval:   1,
+		Anchor:     anchor,
+		Weekday:    anchor.Weekday(),
+		AutoApply:  input.AutoApply != nil && *input.AutoApply,
+	}
+
+	err := applyRule(&rt, input)
+	if err != nil {
+		return rt, err
+	}
+
+	return rs.recurringRepo.Add(ctx, rt)
+}
+
 func (rs *RecurringService) List(ctx context.Context, accountId int64, periodId int64) ([]domain.Recurring, error) {
 	return rs.recurringRepo.List(ctx, accountId, periodId)
 }
 
+// Actualized lists the recurrings actualized in the period with their
+// expected and actual amounts.
+func (rs *RecurringService) Actualized(ctx context.Context, accountId int64, periodId int64) ([]domain.ActualizedRecurring, error) {
+	return rs.recurringRepo.ActualizedInPeriod(ctx, accountId, periodId)
+}
+
 func (rs *RecurringService) Update(ctx context.Context, input types.RecurringInput) (domain.Recurring, error) {
 	r, err := rs.recurringRepo.Single(ctx, input.Id)
 	if err != nil {
@@ -43,6 +81,14 @@ func (rs *RecurringService) Update(ctx context.Context, input types.RecurringInp
 	r.Amount = input.Amount
 	r.Day = input.Day
 	r.CategoryId = input.CategoryId
+	if input.AutoApply != nil {
+		r.AutoApply = *input.AutoApply
+	}
+
+	err = applyRule(&r, input)
+	if err != nil {
+		return r, fmt.Errorf("update recurring %d: %w", input.Id, err)
+	}
 
 	return rs.recurringRepo.Update(ctx, r)
 }
@@ -55,3 +101,121 @@ func (rs *RecurringService) Delete(ctx context.Context, recurringId int64) error
 
 	return rs.recurringRepo.Delete(ctx, recurring)
 }
+
+var ErrorInvalidRecurrence = errors.New("invalid recurrence")
+
+// applyRule validates the input's recurrence rule and sets it on the
+// recurring. Rule fields the input leaves out keep the recurring's values: an
+// empty frequency, a zero interval or anchor date and a nil end date, count or
+// weekday. A supplied end date or count of 0 clears it.
+func applyRule(rt *domain.Recurring, input types.RecurringInput) error {
+	frequency := rt.Frequency
+	if input.Frequency != "" {
+		frequency = input.Frequency
+	}
+	switch frequency {
+	case domain.FrequencyWeekly, domain.FrequencyMonthly, domain.FrequencyYearly:
+	default:
+		return fmt.Errorf("%w: unknown frequency %q", ErrorInvalidRecurrence, frequency)
+	}
+
+	interval := rt.Interval
+	if input.Interval != 0 {
+		interval = input.Interval
+	}
+	count := rt.Count
+	if input.Count != nil {
+		count = *input.Count
+	}
+	if interval < 0 || count < 0 {
+		return fmt.Errorf("%w: interval and count cannot be negative", ErrorInvalidRecurrence)
+	}
+	if input.Day > 31 {
+		return fmt.Errorf("%w: day %d", ErrorInvalidRecurrence, input.Day)
+	}
+
+	anchor := rt.Anchor
+	if input.AnchorDate != 0 {
+		anchor = time.UnixMilli(input.AnchorDate).UTC()
+	}
+
+	until := rt.Until
+	if input.EndDate != nil {
+		until = time.Time{}
+		if *input.EndDate != 0 {
+			until = time.UnixMilli(*input.EndDate).UTC()
+		}
+	}
+	if !until.IsZero() && until.Before(anchor) {
+		return fmt.Errorf("%w: end date is before the anchor date", ErrorInvalidRecurrence)
+	}
+
+	weekday := rt.Weekday
+	if input.Weekday != nil {
+		if *input.Weekday < 0 || *input.Weekday > 6 {
+			return fmt.Errorf("%w: weekday %d", ErrorInvalidRecurrence, *input.Weekday)
+		}
+		weekday = time.Weekday(*input.Weekday)
+	}
+
+	rt.Frequency = frequency
+	rt.Interval = max(interval, 1)
+	rt.Anchor = anchor
+	rt.Until = until
+	rt.Count = count
+	rt.Weekday = weekday
+	if rt.Day == 0 && !anchor.IsZero() {
+		rt.Day = uint8(anchor.Day())
+	}
+	return nil
+}
+
+// autoApplyRecurrings actualizes every pending occurrence of the account's
+// auto-apply recurrings in the period, each dated on its occurrence.
+func autoApplyRecurrings(ctx context.Context, r repo.Repos, accountId int64, periodId int64) ([]domain.Transaction, error) {
+	recurrings, err := r.Recurrings.List(ctx, accountId, periodId)
+	if err != nil {
+		return nil, fmt.Errorf("auto-apply recurrings: %w", err)
+	}
+
+	applied := make([]domain.Transaction, 0, len(recurrings))
+	for _, rt := range recurrings {
+		if !rt.AutoApply {
+			continue
+		}
+
+		for _, o := range rt.Occurrences {
+			if o.Actualized() {
+				continue
+			}
+
+			t, err := actualize(ctx, r, rt.Id, periodId, o.Date, o.Date, rt.Amount)
+			if err != nil {
+				return applied, fmt.Errorf("auto-apply recurring %d: %w", rt.Id, err)
+			}
+			applied = append(applied, t)
+		}
+	}
+
+	return applied, nil
+}
+
+// actualize records the recurring's occurrence as accounted for in the period
+// and adds the transaction for it with the given date and amount.
+func actualize(ctx context.Context, r repo.Repos, recurringId int64, periodId int64, occurrence time.Time, date time.Time, amount int64) (domain.Transaction, error) {
+	recurring, categoryId, err := r.Recurrings.ActualizeRecurring(ctx, recurringId, periodId, occurrence, amount)
+	if err != nil {
+		return domain.Transaction{}, err
+	}
+
+	return r.Transactions.Add(ctx, domain.Transaction{
+		AccountId:             recurring.AccountId,
+		CategoryId:            categoryId,
+		Name:                  recurring.Name,
+		Amount:                recurring.ActualAmount,
+		PeriodId:              periodId,
+		ActualizedRecurringId: recurring.Id,
+		Date:                  date,
+		CanDelete:             true,
+	})
+}
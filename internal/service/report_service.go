@@ -0,0 +1,360 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+	"tjdickerson/sacbooks/internal/repo"
+	"tjdickerson/sacbooks/pkg/types"
+)
+
+var ErrorInvalidRange = errors.New("invalid date range")
+var ErrorInvalidPeriodCount = errors.New("invalid number of periods")
+
+type ReportService struct {
+	reportRepo  *repo.ReportRepo
+	periodRepo  *repo.PeriodRepo
+	accountRepo *repo.AccountRepo
+}
+
+func NewReportService(reportRepo *repo.ReportRepo, periodRepo *repo.PeriodRepo, accountRepo *repo.AccountRepo) *ReportService {
+	return &ReportService{
+		reportRepo:  reportRepo,
+		periodRepo:  periodRepo,
+		accountRepo: accountRepo,
+	}
+}
+
+// SpendingByCategory reports inflows, outflows and share of spending per
+// category over the input's date range, or over its period when no range is
+// given. Opening balances and transfers are not counted.
+func (rs *ReportService) SpendingByCategory(ctx context.Context, input types.ReportRangeInput) (domain.SpendingReport, error) {
+	report := domain.SpendingReport{AccountId: input.AccountId}
+
+	periodId, from, to, err := rs.reportRange(ctx, input)
+	if err != nil {
+		return report, err
+	}
+	report.PeriodId, report.From, report.To = periodId, from, to
+
+	report.Categories, err = rs.reportRepo.SpendingByCategory(ctx, input.AccountId, periodId, from, to)
+	if err != nil {
+		return report, err
+	}
+
+	for _, c := range report.Categories {
+		report.TotalInflow += c.Inflow
+		report.TotalOutflow += c.Outflow
+	}
+	for i := range report.Categories {
+		if report.TotalOutflow != 0 {
+			report.Categories[i].Share = float64(report.Categories[i].Outflow) / float64(report.TotalOutflow) * 100
+		}
+	}
+
+	return report, nil
+}
+
+// IncomeExpense reports income, expense, savings and savings rate over the
+// input's date range, or its period when no range is given, per period and
+// per calendar year. A period that spans the new year counts toward both
+// years by transaction date.
+func (rs *ReportService) IncomeExpense(ctx context.Context, input types.ReportRangeInput) (domain.IncomeExpenseReport, error) {
+	report := domain.IncomeExpenseReport{AccountId: input.AccountId}
+
+	periodId, from, to, err := rs.reportRange(ctx, input)
+	if err != nil {
+		return report, err
+	}
+	report.PeriodId, report.From, report.To = periodId, from, to
+
+	rows, err := rs.reportRepo.IncomeExpense(ctx, input.AccountId, periodId, from, to)
+	if err != nil {
+		return report, err
+	}
+
+	report.Periods = make([]domain.IncomeExpense, 0, len(rows))
+	report.Years = make([]domain.IncomeExpense, 0, 2)
+	periods := make(map[int64]int)
+	years := make(map[int]int)
+	for _, row := range rows {
+		i, ok := periods[row.PeriodId]
+		if !ok {
+			i = len(report.Periods)
+			periods[row.PeriodId] = i
+			report.Periods = append(report.Periods, domain.IncomeExpense{PeriodId: row.PeriodId, Start: row.Start, End: row.End})
+		}
+		report.Periods[i].Income += row.Income
+		report.Periods[i].Expense += row.Expense
+
+		j, ok := years[row.Year]
+		if !ok {
+			j = len(report.Years)
+			years[row.Year] = j
+			report.Years = append(report.Years, domain.IncomeExpense{Year: row.Year})
+		}
+		report.Years[j].Income += row.Income
+		report.Years[j].Expense += row.Expense
+
+		report.Total.Income += row.Income
+		report.Total.Expense += row.Expense
+	}
+
+	sort.Slice(report.Years, func(i, j int) bool {
+		return report.Years[i].Year < report.Years[j].Year
+	})
+	for i := range report.Periods {
+		settleSavings(&report.Periods[i])
+	}
+	for i := range report.Years {
+		settleSavings(&report.Years[i])
+	}
+	settleSavings(&report.Total)
+
+	return report, nil
+}
+
+func settleSavings(ie *domain.IncomeExpense) {
+	ie.Savings = ie.Income - ie.Expense
+	if ie.Income > 0 {
+		ie.SavingsRate = float64(ie.Savings) / float64(ie.Income) * 100
+	}
+}
+
+// reportRange resolves the input to a period id and an inclusive date range.
+// A From or To date selects a date range across periods, with the other end
+// defaulting to today or the start of the account's history. Otherwise the
+// range is the period's, the active period when PeriodId is 0.
+func (rs *ReportService) reportRange(ctx context.Context, input types.ReportRangeInput) (int64, time.Time, time.Time, error) {
+	if input.From == 0 && input.To == 0 {
+		period, err := rs.periodRepo.GetPeriod(ctx, input.AccountId, input.PeriodId)
+		if err != nil {
+			return 0, time.Time{}, time.Time{}, fmt.Errorf("report period: %w", err)
+		}
+		return period.Id, period.ReportingStart, period.ReportingEnd, nil
+	}
+
+	from := time.UnixMilli(input.From).UTC()
+	to := time.Now().UTC()
+	if input.To != 0 {
+		to = time.UnixMilli(input.To).UTC()
+	}
+	if to.Before(from) {
+		return 0, from, to, fmt.Errorf("%w: %s is before %s", ErrorInvalidRange, to.Format(time.DateOnly), from.Format(time.DateOnly))
+	}
+
+	return 0, from, to, nil
+}
+
+// DefaultTrendPeriods and MaxTrendPeriods bound how many periods a trend
+// report covers.
+const (
+	DefaultTrendPeriods = 6
+	MaxTrendPeriods     = 36
+)
+
+// Trend reports each category's spending over the account's most recent
+// periods, including the active one, with the average, range and change
+// from one period to the next.
+func (rs *ReportService) Trend(ctx context.Context, accountId int64, periods int) (domain.TrendReport, error) {
+	report := domain.TrendReport{AccountId: accountId}
+
+	if periods < 0 || periods > MaxTrendPeriods {
+		return report, fmt.Errorf("%w: %d", ErrorInvalidPeriodCount, periods)
+	}
+	if periods == 0 {
+		periods = DefaultTrendPeriods
+	}
+
+	summaries, err := rs.periodRepo.ListPeriods(ctx, accountId)
+	if err != nil {
+		return report, err
+	}
+
+	summaries = summaries[:min(periods, len(summaries))]
+	report.Periods = make([]domain.Period, len(summaries))
+	for i, p := range summaries {
+		report.Periods[len(summaries)-1-i] = p.Period
+	}
+	if len(report.Periods) == 0 {
+		return report, nil
+	}
+
+	totals, err := rs.reportRepo.CategoryTotalsByPeriod(ctx, accountId, report.Periods[0].ReportingStart)
+	if err != nil {
+		return report, err
+	}
+
+	trends := make(map[int64]*domain.CategoryTrend)
+	order := make([]int64, 0, 10)
+	for i, p := range report.Periods {
+		for _, t := range totals[p.Id] {
+			trend, ok := trends[t.Id]
+			if !ok {
+				trend = &domain.CategoryTrend{Category: t.Category, Spent: make([]int64, len(report.Periods))}
+				trends[t.Id] = trend
+				order = append(order, t.Id)
+			}
+			trend.Spent[i] = -t.Amount
+		}
+	}
+
+	report.Categories = make([]domain.CategoryTrend, 0, len(order))
+	for _, id := range order {
+		trend := trends[id]
+		trend.Deltas = make([]int64, len(trend.Spent))
+		trend.Min, trend.Max = trend.Spent[0], trend.Spent[0]
+		for i, spent := range trend.Spent {
+			trend.Total += spent
+			trend.Min = min(trend.Min, spent)
+			trend.Max = max(trend.Max, spent)
+			if i > 0 {
+				trend.Deltas[i] = spent - trend.Spent[i-1]
+			}
+		}
+		trend.Average = float64(trend.Total) / float64(len(trend.Spent))
+		report.Categories = append(report.Categories, *trend)
+	}
+
+	sort.SliceStable(report.Categories, func(i, j int) bool {
+		return report.Categories[i].Total > report.Categories[j].Total
+	})
+
+	return report, nil
+}
+
+// NetWorth totals every account's balance at the end of each period, or at
+// the end of each calendar month when the accounts start their periods on
+// different days. Balances are by transaction date and run through the end
+// of the latest period.
+func (rs *ReportService) NetWorth(ctx context.Context) (domain.NetWorth, error) {
+	var worth domain.NetWorth
+
+	accounts, err := rs.accountRepo.List(ctx)
+	if err != nil {
+		return worth, fmt.Errorf("net worth: %w", err)
+	}
+	if len(accounts) == 0 {
+		return worth, nil
+	}
+
+	worth.ByPeriod = true
+	ends := make(map[time.Time]bool)
+	var first, last time.Time
+	for _, a := range accounts {
+		if a.PeriodStartDay != accounts[0].PeriodStartDay {
+			worth.ByPeriod = false
+		}
+
+		periods, err := rs.periodRepo.ListPeriods(ctx, a.Id)
+		if err != nil {
+			return worth, fmt.Errorf("net worth: %w", err)
+		}
+		for _, p := range periods {
+			start, end := domain.Noon(p.ReportingStart), domain.Noon(p.ReportingEnd)
+			ends[end] = true
+			if first.IsZero() || start.Before(first) {
+				first = start
+			}
+			if end.After(last) {
+				last = end
+			}
+		}
+	}
+
+	dates := make([]time.Time, 0, len(ends))
+	if worth.ByPeriod {
+		for d := range ends {
+			dates = append(dates, d)
+		}
+		sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	} else {
+		for m := time.Date(first.Year(), first.Month(), 1, 12, 0, 0, 0, time.UTC); !m.After(last); m = m.AddDate(0, 1, 0) {
+			dates = append(dates, m.AddDate(0, 1, -1))
+		}
+	}
+
+	changes, err := rs.reportRepo.DailyChanges(ctx)
+	if err != nil {
+		return worth, fmt.Errorf("net worth: %w", err)
+	}
+
+	balances := make(map[int64]int64, len(accounts))
+	next := 0
+	worth.Points = make([]domain.NetWorthPoint, 0, len(dates))
+	for _, d := range dates {
+		for ; next < len(changes) && !changes[next].Date.After(d); next++ {
+			balances[changes[next].AccountId] += changes[next].Amount
+		}
+
+		point := domain.NetWorthPoint{Date: d, Accounts: make([]domain.AccountBalance, 0, len(accounts))}
+		for _, a := range accounts {
+			point.Accounts = append(point.Accounts, domain.AccountBalance{AccountId: a.Id, Name: a.Name, Balance: balances[a.Id]})
+			point.Total += balances[a.Id]
+		}
+		worth.Points = append(worth.Points, point)
+	}
+
+	return worth, nil
+}
+
+// BalanceSeries runs the account's balance through the period, or the active
+// period when periodId is 0, from its opening balance. Transactions dated
+// outside the period count on its first or last day.
+func (rs *ReportService) BalanceSeries(ctx context.Context, accountId int64, periodId int64) (domain.BalanceSeries, error) {
+	series := domain.BalanceSeries{AccountId: accountId}
+
+	period, err := rs.periodRepo.GetPeriod(ctx, accountId, periodId)
+	if err != nil {
+		return series, fmt.Errorf("balance series: %w", err)
+	}
+	series.Period = period
+
+	transactions, err := rs.reportRepo.PeriodTransactionsByDate(ctx, accountId, period.Id)
+	if err != nil {
+		return series, fmt.Errorf("balance series: %w", err)
+	}
+
+	first, last := domain.Noon(period.ReportingStart), domain.Noon(period.ReportingEnd)
+	changes := make(map[time.Time]int64)
+	counts := make(map[time.Time]int)
+
+	var balance int64
+	series.Entries = make([]domain.BalanceEntry, 0, len(transactions))
+	for _, t := range transactions {
+		balance += t.Amount
+		series.Entries = append(series.Entries, domain.BalanceEntry{Transaction: t, Balance: balance})
+
+		if !t.CanDelete {
+			series.OpeningBalance += t.Amount
+			continue
+		}
+
+		day := domain.Noon(t.Date)
+		if day.Before(first) {
+			day = first
+		}
+		if day.After(last) {
+			day = last
+		}
+		changes[day] += t.Amount
+		counts[day]++
+	}
+
+	balance = series.OpeningBalance
+	series.Days = make([]domain.DailyBalance, 0, 31)
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		balance += changes[d]
+		series.Days = append(series.Days, domain.DailyBalance{
+			Date:    d,
+			Balance: balance,
+			Change:  changes[d],
+			Count:   counts[d],
+		})
+	}
+
+	return series, nil
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"testing"
+	"time"
+	"tjdickerson/sacbooks/internal/domain"
+	"tjdickerson/sacbooks/internal/repo"
+	"tjdickerson/sacbooks/internal/schema"
+	"tjdickerson/sacbooks/pkg/types"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+}
+
+// reportFixture holds the ids of the ledger built by newReportFixture.
+type reportFixture struct {
+	reports   *ReportService
+	checking  int64
+	savings   int64
+	first     int64
+	second    int64
+	groceries int64
+	household int64
+	salary    int64
+}
+
+// newReportFixture migrates an in-memory database and fills in two checking
+// periods that straddle the new year:
+//
+//	first  Dec 15 2025 - Jan 14 2026: opening balance 1000, salary 5000 on
+//	       Dec 20, groceries -300 on Jan 5
+//	second Jan 15 2026 - Feb 14 2026: carried opening balance 5700, a -500
+//	       market run split -200 groceries and -300 household on Jan 20,
+//	       a -1000 transfer to savings on Jan 25, a 20 groceries refund on
+//	       Jan 28, and two rows filed here but dated outside the period:
+//	       -10 groceries on Jan 10 and -50 household on Feb 20
+//
+// The first period is closed. Savings has one period matching the second,
+// with an opening balance of 0 and the transfer's 1000.
+func newReportFixture(t *testing.T) reportFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("open database: %s", err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	pending, err := schema.Pending(ctx, db)
+	if err != nil {
+		t.Fatalf("pending migrations: %s", err)
+	}
+	if err := schema.Migrate(ctx, db, pending); err != nil {
+		t.Fatalf("migrate: %s", err)
+	}
+
+	r := repo.NewRepos(db)
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("build fixture: %s", err)
+		}
+	}
+
+	var f reportFixture
+	account := func(name string) int64 {
+		a, err := r.Accounts.Add(ctx, domain.Account{Name: name, PeriodStartDay: 15})
+		must(err)
+		return a.Id
+	}
+	period := func(accountId int64, start, end time.Time) int64 {
+		p, err := r.Periods.StartPeriod(ctx, accountId, start, end, start)
+		must(err)
+		return p.Id
+	}
+	category := func(name, kind string) int64 {
+		c, err := r.Categories.Add(ctx, domain.Category{AccountId: f.checking, Name: name, Kind: kind})
+		must(err)
+		return c.Id
+	}
+	add := func(t domain.Transaction) domain.Transaction {
+		if t.AccountId == 0 {
+			t.AccountId = f.checking
+		}
+		added, err := r.Transactions.Add(ctx, t)
+		must(err)
+		return added
+	}
+
+	f.checking = account("Checking")
+	f.savings = account("Savings")
+	f.first = period(f.checking, date(2025, time.December, 15), date(2026, time.January, 14))
+	f.second = period(f.checking, date(2026, time.January, 15), date(2026, time.February, 14))
+	savingsPeriod := period(f.savings, date(2026, time.January, 15), date(2026, time.February, 14))
+	f.groceries = category("Groceries", domain.CategoryKindAuto)
+	f.household = category("Household", domain.CategoryKindAuto)
+	f.salary = category("Salary", domain.CategoryKindIncome)
+
+	add(domain.Transaction{PeriodId: f.first, Name: "Opening Balance", Amount: 1000, Date: date(2025, time.December, 15)})
+	add(domain.Transaction{PeriodId: f.first, CategoryId: f.salary, Name: "Pay", Amount: 5000, Date: date(2025, time.December, 20), CanDelete: true})
+	add(domain.Transaction{PeriodId: f.first, CategoryId: f.groceries, Name: "Market", Amount: -300, Date: date(2026, time.January, 5), CanDelete: true})
+
+	must(r.Periods.ClosePeriod(ctx, f.first))
+	add(domain.Transaction{PeriodId: f.second, Name: "Opening Balance", Amount: 5700, Date: date(2026, time.January, 15)})
+	market := add(domain.Transaction{PeriodId: f.second, CategoryId: f.groceries, Name: "Market", Amount: -500, Date: date(2026, time.January, 20), CanDelete: true})
+	_, err = r.Splits.Replace(ctx, market.Id, []domain.TransactionSplit{
+		{CategoryId: f.groceries, Amount: -200},
+		{CategoryId: f.household, Amount: -300},
+	})
+	must(err)
+
+	transfer, err := r.Transfers.Add(ctx, domain.Transfer{FromAccountId: f.checking, ToAccountId: f.savings})
+	must(err)
+	add(domain.Transaction{PeriodId: f.second, Name: "To savings", Amount: -1000, Date: date(2026, time.January, 25), CanDelete: true, TransferId: transfer.Id})
+	add(domain.Transaction{AccountId: f.savings, PeriodId: savingsPeriod, Name: "Opening Balance", Date: date(2026, time.January, 15)})
+	add(domain.Transaction{AccountId: f.savings, PeriodId: savingsPeriod, Name: "From checking", Amount: 1000, Date: date(2026, time.January, 25), CanDelete: true, TransferId: transfer.Id})
+
+	add(domain.Transaction{PeriodId: f.second, CategoryId: f.groceries, Name: "Refund", Amount: 20, Date: date(2026, time.January, 28), CanDelete: true})
+	add(domain.Transaction{PeriodId: f.second, CategoryId: f.groceries, Name: "Early", Amount: -10, Date: date(2026, time.January, 10), CanDelete: true})
+	add(domain.Transaction{PeriodId: f.second, CategoryId: f.household, Name: "Late", Amount: -50, Date: date(2026, time.February, 20), CanDelete: true})
+
+	f.reports = NewReportService(r.Reports, r.Periods, r.Accounts)
+	return f
+}
+
+type categoryLine struct {
+	name    string
+	inflow  int64
+	outflow int64
+	count   int
+}
+
+func TestSpendingByCategory(t *testing.T) {
+	f := newReportFixture(t)
+
+	tests := []struct {
+		name  string
+		input types.ReportRangeInput
+		want  []categoryLine
+	}{
+		{
+			name:  "period counts every row filed in it",
+			input: types.ReportRangeInput{AccountId: f.checking, PeriodId: f.second},
+			want: []categoryLine{
+				{name: "Household", outflow: -350, count: 2},
+				{name: "Groceries", inflow: 20, outflow: -210, count: 3},
+			},
+		},
+		{
+			name: "date range counts rows by date",
+			input: types.ReportRangeInput{
+				AccountId: f.checking,
+				From:      date(2026, time.January, 15).UnixMilli(),
+				To:        date(2026, time.February, 14).UnixMilli(),
+			},
+			want: []categoryLine{
+				{name: "Household", outflow: -300, count: 1},
+				{name: "Groceries", inflow: 20, outflow: -200, count: 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := f.reports.SpendingByCategory(context.Background(), tt.input)
+			if err != nil {
+				t.Fatalf("SpendingByCategory error: %s", err)
+			}
+
+			got := make([]categoryLine, 0, len(report.Categories))
+			for _, c := range report.Categories {
+				got = append(got, categoryLine{name: c.Name, inflow: c.Inflow, outflow: c.Outflow, count: c.Count})
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SpendingByCategory() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIncomeExpense(t *testing.T) {
+	f := newReportFixture(t)
+
+	report, err := f.reports.IncomeExpense(context.Background(), types.ReportRangeInput{
+		AccountId: f.checking,
+		From:      date(2025, time.December, 15).UnixMilli(),
+		To:        date(2026, time.February, 14).UnixMilli(),
+	})
+	if err != nil {
+		t.Fatalf("IncomeExpense error: %s", err)
+	}
+
+	type row struct {
+		id      int64
+		income  int64
+		expense int64
+	}
+
+	periods := make([]row, 0, len(report.Periods))
+	for _, p := range report.Periods {
+		periods = append(periods, row{id: p.PeriodId, income: p.Income, expense: p.Expense})
+	}
+	wantPeriods := []row{{id: f.first, income: 5000, expense: 300}, {id: f.second, income: 20, expense: 510}}
+	if !slices.Equal(periods, wantPeriods) {
+		t.Errorf("periods = %+v, want %+v", periods, wantPeriods)
+	}
+
+	years := make([]row, 0, len(report.Years))
+	for _, y := range report.Years {
+		years = append(years, row{id: int64(y.Year), income: y.Income, expense: y.Expense})
+	}
+	wantYears := []row{{id: 2025, income: 5000}, {id: 2026, income: 20, expense: 810}}
+	if !slices.Equal(years, wantYears) {
+		t.Errorf("years = %+v, want %+v", years, wantYears)
+	}
+
+	if report.Total.Savings != 4210 {
+		t.Errorf("total savings = %d, want 4210", report.Total.Savings)
+	}
+	if report.Years[0].SavingsRate != 100 {
+		t.Errorf("2025 savings rate = %f, want 100", report.Years[0].SavingsRate)
+	}
+}
+
+func TestTrend(t *testing.T) {
+	f := newReportFixture(t)
+
+	report, err := f.reports.Trend(context.Background(), f.checking, 0)
+	if err != nil {
+		t.Fatalf("Trend error: %s", err)
+	}
+
+	if len(report.Periods) != 2 || report.Periods[0].Id != f.first || report.Periods[1].Id != f.second {
+		t.Fatalf("Trend periods = %+v, want first then second", report.Periods)
+	}
+
+	want := map[string][]int64{
+		"Groceries": {300, 190},
+		"Household": {0, 350},
+		"Salary":    {-5000, 0},
+	}
+	order := make([]string, 0, len(report.Categories))
+	for _, c := range report.Categories {
+		order = append(order, c.Name)
+		if !slices.Equal(c.Spent, want[c.Name]) {
+			t.Errorf("%s spent = %v, want %v", c.Name, c.Spent, want[c.Name])
+		}
+	}
+	if wantOrder := []string{"Groceries", "Household", "Salary"}; !slices.Equal(order, wantOrder) {
+		t.Errorf("Trend categories = %v, want %v", order, wantOrder)
+	}
+}
+
+func TestNetWorth(t *testing.T) {
+	f := newReportFixture(t)
+
+	worth, err := f.reports.NetWorth(context.Background())
+	if err != nil {
+		t.Fatalf("NetWorth error: %s", err)
+	}
+
+	if !worth.ByPeriod {
+		t.Errorf("NetWorth ByPeriod = false, want true")
+	}
+
+	type point struct {
+		date     string
+		checking int64
+		savings  int64
+	}
+	got := make([]point, 0, len(worth.Points))
+	for _, p := range worth.Points {
+		got = append(got, point{date: p.Date.Format(time.DateOnly), checking: p.Accounts[0].Balance, savings: p.Accounts[1].Balance})
+	}
+
+	// Only the first opening balance counts, and the late row is after the
+	// last period end.
+	want := []point{
+		{date: "2026-01-14", checking: 5690},
+		{date: "2026-02-14", checking: 4210, savings: 1000},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("NetWorth() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBalanceSeries(t *testing.T) {
+	f := newReportFixture(t)
+
+	series, err := f.reports.BalanceSeries(context.Background(), f.checking, f.second)
+	if err != nil {
+		t.Fatalf("BalanceSeries error: %s", err)
+	}
+
+	if series.OpeningBalance != 5700 {
+		t.Errorf("opening balance = %d, want 5700", series.OpeningBalance)
+	}
+	if len(series.Entries) != 6 {
+		t.Fatalf("got %d entries, want 6", len(series.Entries))
+	}
+	if last := series.Entries[len(series.Entries)-1]; last.Name != "Late" || last.Balance != 4160 {
+		t.Errorf("last entry = %s %d, want Late 4160", last.Name, last.Balance)
+	}
+
+	if len(series.Days) != 31 {
+		t.Fatalf("got %d days, want 31", len(series.Days))
+	}
+
+	tests := []struct {
+		day     int
+		date    string
+		balance int64
+		change  int64
+		count   int
+	}{
+		{day: 0, date: "2026-01-15", balance: 5690, change: -10, count: 1},
+		{day: 5, date: "2026-01-20", balance: 5190, change: -500, count: 1},
+		{day: 10, date: "2026-01-25", balance: 4190, change: -1000, count: 1},
+		{day: 29, date: "2026-02-13", balance: 4210},
+		{day: 30, date: "2026-02-14", balance: 4160, change: -50, count: 1},
+	}
+	for _, tt := range tests {
+		d := series.Days[tt.day]
+		if got := d.Date.Format(time.DateOnly); got != tt.date {
+			t.Errorf("day %d date = %s, want %s", tt.day, got, tt.date)
+		}
+		if d.Balance != tt.balance || d.Change != tt.change || d.Count != tt.count {
+			t.Errorf("%s = balance %d change %d count %d, want %d %d %d", tt.date, d.Balance, d.Change, d.Count, tt.balance, tt.change, tt.count)
+		}
+	}
+}
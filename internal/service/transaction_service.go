@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 	"tjdickerson/sacbooks/internal/domain"
 	"tjdickerson/sacbooks/internal/repo"
@@ -10,73 +12,567 @@ import (
 )
 
 type TransactionService struct {
+	uow             *repo.UnitOfWork
 	transactionRepo *repo.TransactionRepo
+	splitRepo       *repo.TransactionSplitRepo
 	recurringRepo   *repo.RecurringRepo
 	accountRepo     *repo.AccountRepo
 }
 
-func NewTransactionService(transactionRepo *repo.TransactionRepo, recurringRepo *repo.RecurringRepo, accountRepo *repo.AccountRepo) *TransactionService {
+func NewTransactionService(uow *repo.UnitOfWork, transactionRepo *repo.TransactionRepo, splitRepo *repo.TransactionSplitRepo, recurringRepo *repo.RecurringRepo, accountRepo *repo.AccountRepo) *TransactionService {
 	return &TransactionService{
+		uow:             uow,
 		transactionRepo: transactionRepo,
+		splitRepo:       splitRepo,
 		recurringRepo:   recurringRepo,
 		accountRepo:     accountRepo,
 	}
 }
 
 func (ts *TransactionService) List(ctx context.Context, accountId int64, periodId int64, limit int, offset int) ([]domain.Transaction, error) {
-	return ts.transactionRepo.List(ctx, accountId, periodId, limit, offset)
+	transactions, err := ts.transactionRepo.List(ctx, accountId, periodId, limit, offset)
+	if err != nil {
+		return transactions, err
+	}
+
+	return ts.withSplits(ctx, transactions)
 }
 
+// withSplits fills in the splits of each transaction in the list.
+func (ts *TransactionService) withSplits(ctx context.Context, transactions []domain.Transaction) ([]domain.Transaction, error) {
+	ids := make([]int64, len(transactions))
+	for i, t := range transactions {
+		ids[i] = t.Id
+	}
+
+	splits, err := ts.splitRepo.ListForTransactions(ctx, ids)
+	if err != nil {
+		return transactions, err
+	}
+
+	for i := range transactions {
+		transactions[i].Splits = splits[transactions[i].Id]
+	}
+	return transactions, nil
+}
+
+var ErrorInvalidOrigin = errors.New("invalid origin")
+
+const defaultSearchLimit = 50
+
+// Search returns a page of matching transactions and the total match count.
+func (ts *TransactionService) Search(ctx context.Context, input types.TransactionSearchInput) ([]domain.Transaction, int, error) {
+	switch input.Origin {
+	case domain.OriginAny, domain.OriginRecurring, domain.OriginManual, domain.OriginTransfer:
+	default:
+		return nil, 0, fmt.Errorf("%w: %q", ErrorInvalidOrigin, input.Origin)
+	}
+
+	start, end := dayRange(input.StartDate, input.EndDate)
+	f := domain.TransactionFilter{
+		AccountId:   input.AccountId,
+		Text:        strings.TrimSpace(input.Text),
+		MinAmount:   input.MinAmount,
+		MaxAmount:   input.MaxAmount,
+		StartDate:   start,
+		EndDate:     end,
+		CategoryIds: input.CategoryIds,
+		Origin:      input.Origin,
+		SortBy:      input.SortBy,
+		SortDesc:    input.SortDesc,
+		Limit:       input.Limit,
+		Offset:      input.Offset,
+	}
+	if f.Limit <= 0 {
+		f.Limit = defaultSearchLimit
+	}
+
+	transactions, total, err := ts.transactionRepo.Search(ctx, f)
+	if err != nil {
+		return transactions, total, err
+	}
+
+	transactions, err = ts.withSplits(ctx, transactions)
+	return transactions, total, err
+}
+
+var ErrorEmptyQuery = errors.New("empty search query")
+var ErrorFullTextSearchUnavailable = errors.New("full text search is unavailable, sqlite was built without FTS5")
+
+// FullTextSearch ranks transactions whose name or notes match the query.
+// Words must all match, a trailing * matches by prefix and double quotes
+// match a phrase.
+func (ts *TransactionService) FullTextSearch(ctx context.Context, input types.FullTextSearchInput) ([]domain.TransactionMatch, error) {
+	query := ftsQuery(input.Query)
+	if query == "" {
+		return nil, ErrorEmptyQuery
+	}
+
+	indexed, err := ts.transactionRepo.HasFullTextIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !indexed {
+		return nil, ErrorFullTextSearchUnavailable
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	return ts.transactionRepo.FullTextSearch(ctx, input.AccountId, query, limit, input.Offset)
+}
+
+// ftsQuery turns user input into an FTS5 match expression. Every term is
+// quoted so punctuation and FTS5 operators in the input are taken literally.
+func ftsQuery(input string) string {
+	var terms []string
+	add := func(term string, prefix bool) {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			return
+		}
+		quoted := `"` + strings.ReplaceAll(term, `"`, `""`) + `"`
+		if prefix {
+			quoted += "*"
+		}
+		terms = append(terms, quoted)
+	}
+
+	rest := strings.TrimSpace(input)
+	for rest != "" {
+		if rest[0] == '"' {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				add(rest[1:], false)
+				break
+			}
+			phrase := rest[1 : end+1]
+			rest = rest[end+2:]
+			prefix := strings.HasPrefix(rest, "*")
+			if prefix {
+				rest = rest[1:]
+			}
+			add(phrase, prefix)
+		} else {
+			word, tail, _ := strings.Cut(rest, " ")
+			rest = tail
+			if strings.Contains(word, `"`) {
+				word, tail, _ = strings.Cut(word, `"`)
+				rest = `"` + tail + " " + rest
+			}
+			prefix := strings.HasSuffix(word, "*")
+			add(strings.TrimRight(word, "*"), prefix)
+		}
+		rest = strings.TrimSpace(rest)
+	}
+
+	return strings.Join(terms, " ")
+}
+
+var ErrorInvalidSplits = errors.New("invalid splits")
+
+// splitsFromInput checks that the lines can divide amount. There must be at
+// least two lines, each with a category and a non-zero amount, and together
+// they must add up to amount.
+func splitsFromInput(amount int64, input []types.TransactionSplitInput) ([]domain.TransactionSplit, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+	if len(input) == 1 {
+		return nil, fmt.Errorf("%w: a split needs at least two lines", ErrorInvalidSplits)
+	}
+
+	splits := make([]domain.TransactionSplit, 0, len(input))
+	var sum int64
+	for i, line := range input {
+		if line.CategoryId == 0 {
+			return nil, fmt.Errorf("%w: line %d has no category", ErrorInvalidSplits, i+1)
+		}
+		if line.Amount == 0 {
+			return nil, fmt.Errorf("%w: line %d has no amount", ErrorInvalidSplits, i+1)
+		}
+
+		sum += line.Amount
+		splits = append(splits, domain.TransactionSplit{
+			CategoryId: line.CategoryId,
+			Amount:     line.Amount,
+			Memo:       strings.TrimSpace(line.Memo),
+		})
+	}
+
+	if sum != amount {
+		return nil, fmt.Errorf("%w: lines add up to %d, not %d", ErrorInvalidSplits, sum, amount)
+	}
+
+	return splits, nil
+}
+
+// Add inserts the transaction and its splits. A split transaction without a
+// category of its own takes the category of its first line.
 func (ts *TransactionService) Add(ctx context.Context, input types.TransactionInsertInput) (domain.Transaction, error) {
+	splits, err := splitsFromInput(input.Amount, input.Splits)
+	if err != nil {
+		return domain.Transaction{}, fmt.Errorf("add transaction: %w", err)
+	}
+
+	categoryId := input.CategoryId
+	if categoryId == 0 && len(splits) > 0 {
+		categoryId = splits[0].CategoryId
+	}
+
 	date := time.UnixMilli(input.Date).UTC()
-	return ts.transactionRepo.Add(ctx, domain.Transaction{
-		AccountId:  input.AccountId,
-		PeriodId:   input.PeriodId,
-		CategoryId: input.CategoryId,
-		Name:       input.Name,
-		Amount:     input.Amount,
-		Date:       date,
+	var transaction domain.Transaction
+	err = ts.uow.Do(ctx, func(r repo.Repos) error {
+		t, err := r.Transactions.Add(ctx, domain.Transaction{
+			AccountId:  input.AccountId,
+			PeriodId:   input.PeriodId,
+			CategoryId: categoryId,
+			Name:       input.Name,
+			Notes:      input.Notes,
+			Amount:     input.Amount,
+			Date:       date,
+			CanDelete:  true,
+		})
+		if err != nil {
+			return err
+		}
+
+		t.Splits, err = r.Splits.Replace(ctx, t.Id, splits)
+		transaction = t
+		return err
 	})
+
+	return transaction, err
 }
 
+// Update saves the transaction. Its splits are replaced with the ones given,
+// removed when Splits is empty or ClearSplits is set, and kept when Splits is
+// nil as long as they still add up to the new amount.
 func (ts *TransactionService) Update(ctx context.Context, input types.TransactionUpdateInput) (domain.Transaction, error) {
-	transaction, err := ts.transactionRepo.Single(ctx, input.Id)
+	if input.ClearSplits && len(input.Splits) > 0 {
+		return domain.Transaction{}, fmt.Errorf("update transaction %d: %w: lines given with clear_splits", input.Id, ErrorInvalidSplits)
+	}
+
+	keepSplits := input.Splits == nil && !input.ClearSplits
+	splits, err := splitsFromInput(input.Amount, input.Splits)
 	if err != nil {
-		return transaction, fmt.Errorf("update transaction %d: %w", input.Id, err)
+		return domain.Transaction{}, fmt.Errorf("update transaction %d: %w", input.Id, err)
 	}
 
-	date := time.UnixMilli(input.Date).UTC()
+	var transaction domain.Transaction
+	err = ts.uow.Do(ctx, func(r repo.Repos) error {
+		t, err := r.Transactions.Single(ctx, input.Id)
+		if err != nil {
+			return fmt.Errorf("update transaction %d: %w", input.Id, err)
+		}
+
+		if keepSplits {
+			stored, err := r.Splits.ListForTransactions(ctx, []int64{t.Id})
+			if err != nil {
+				return fmt.Errorf("update transaction %d: %w", input.Id, err)
+			}
+			splits = stored[t.Id]
+			if err := checkSplitTotal(splits, input.Amount); err != nil {
+				return fmt.Errorf("update transaction %d: %w", input.Id, err)
+			}
+		}
+
+		t.Name = input.Name
+		if input.Notes != nil {
+			t.Notes = *input.Notes
+		}
+		t.Amount = input.Amount
+		t.Date = time.UnixMilli(input.Date).UTC()
+		t.CategoryId = input.CategoryId
+		if t.CategoryId == 0 && len(splits) > 0 {
+			t.CategoryId = splits[0].CategoryId
+		}
+
+		if t.Status == domain.StatusReconciled {
+			return fmt.Errorf("update transaction %d: %w", input.Id, repo.ErrorReconciledTransaction)
+		}
+		if t.TransferId != 0 && len(splits) > 0 {
+			return fmt.Errorf("update transaction %d: %w", input.Id, ErrorTransferSplit)
+		}
+
+		t, err = r.Transactions.Update(ctx, t)
+		if err != nil {
+			return err
+		}
 
-	transaction.Name = input.Name
-	transaction.Amount = input.Amount
-	transaction.Date = date
+		if t.TransferId != 0 {
+			if err := updateTransferCounterpart(ctx, r, t); err != nil {
+				return fmt.Errorf("update transaction %d: %w", input.Id, err)
+			}
+		}
 
-	return ts.transactionRepo.Update(ctx, transaction)
+		if keepSplits {
+			t.Splits = splits
+		} else {
+			t.Splits, err = r.Splits.Replace(ctx, t.Id, splits)
+		}
+		transaction = t
+		return err
+	})
+
+	return transaction, err
 }
 
-func (ts *TransactionService) Delete(ctx context.Context, transactionId int64) error {
-	transaction, err := ts.transactionRepo.Single(ctx, transactionId)
+// checkSplitTotal makes sure stored splits still divide amount exactly.
+func checkSplitTotal(splits []domain.TransactionSplit, amount int64) error {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	var sum int64
+	for _, s := range splits {
+		sum += s.Amount
+	}
+	if sum != amount {
+		return fmt.Errorf("%w: lines add up to %d, not %d", ErrorInvalidSplits, sum, amount)
+	}
+	return nil
+}
+
+// updateTransferCounterpart keeps the other side of a transfer mirroring t.
+// Names are left alone since each side names the account on the other end.
+func updateTransferCounterpart(ctx context.Context, r repo.Repos, t domain.Transaction) error {
+	other, err := r.Transactions.TransferCounterpart(ctx, t)
 	if err != nil {
-		return fmt.Errorf("delete transaction %d: %w", transactionId, err)
+		return err
+	}
+	if other.Status == domain.StatusReconciled {
+		return fmt.Errorf("transfer counterpart %d: %w", other.Id, repo.ErrorReconciledTransaction)
 	}
 
-	return ts.transactionRepo.Delete(ctx, transaction)
+	other.Amount = -t.Amount
+	other.Date = t.Date
+	other.Notes = t.Notes
+	_, err = r.Transactions.Update(ctx, other)
+	return err
 }
 
-func (ts *TransactionService) ApplyRecurring(ctx context.Context, recurringId int64, periodId int64) (domain.Transaction, error) {
-	recurring, categoryId, err := ts.recurringRepo.ActualizeRecurring(ctx, recurringId, periodId)
+// Delete removes the transaction, and when it is one side of a transfer the
+// other side and the transfer with it.
+func (ts *TransactionService) Delete(ctx context.Context, transactionId int64) error {
+	return ts.uow.Do(ctx, func(r repo.Repos) error {
+		transaction, err := r.Transactions.Single(ctx, transactionId)
+		if err != nil {
+			return fmt.Errorf("delete transaction %d: %w", transactionId, err)
+		}
 
-	if err != nil {
-		return domain.Transaction{}, fmt.Errorf("apply recurring: %w", err)
+		if err := r.Transactions.Delete(ctx, transaction); err != nil {
+			return err
+		}
+
+		if transaction.TransferId == 0 {
+			return nil
+		}
+
+		other, err := r.Transactions.TransferCounterpart(ctx, transaction)
+		if err != nil {
+			return fmt.Errorf("delete transaction %d: %w", transactionId, err)
+		}
+		if err := r.Transactions.Delete(ctx, other); err != nil {
+			return err
+		}
+
+		return r.Transfers.Delete(ctx, transaction.TransferId)
+	})
+}
+
+var ErrorInvalidStatus = errors.New("invalid status")
+
+// SetStatus marks a transaction cleared or uncleared. Transactions only
+// become reconciled by finalizing a reconciliation.
+func (ts *TransactionService) SetStatus(ctx context.Context, transactionId int64, status string) (domain.Transaction, error) {
+	switch status {
+	case domain.StatusUncleared, domain.StatusCleared:
+	default:
+		return domain.Transaction{}, fmt.Errorf("%w: %q", ErrorInvalidStatus, status)
+	}
+
+	if _, err := ts.transactionRepo.Single(ctx, transactionId); err != nil {
+		return domain.Transaction{}, err
+	}
+
+	return ts.transactionRepo.SetStatus(ctx, transactionId, status)
+}
+
+var (
+	ErrorTransferSplit       = errors.New("transfers can't be split")
+	ErrorTransferSameAccount = errors.New("can't transfer to the same account")
+	ErrorTransferAmount      = errors.New("transfer amount must be positive")
+)
+
+// Transfer moves money between two accounts as a debit in the source
+// account's active period and a matching credit in the destination's, both
+// linked to one transfer.
+func (ts *TransactionService) Transfer(ctx context.Context, input types.TransferInput) (domain.Transaction, domain.Transaction, error) {
+	var from, to domain.Transaction
+
+	if input.FromAccountId == input.ToAccountId {
+		return from, to, ErrorTransferSameAccount
+	}
+	if input.Amount <= 0 {
+		return from, to, ErrorTransferAmount
+	}
+
+	date := time.UnixMilli(input.Date).UTC()
+	if input.Date == 0 {
+		date = time.Now().UTC()
 	}
 
-	return ts.transactionRepo.Add(ctx, domain.Transaction{
-		AccountId:             recurring.AccountId,
-		CategoryId:            categoryId,
-		Name:                  recurring.Name,
-		Amount:                recurring.Amount,
-		PeriodId:              periodId,
-		ActualizedRecurringId: recurring.Id,
-		Date:                  time.Now().UTC(),
+	err := ts.uow.Do(ctx, func(r repo.Repos) error {
+		fromAccount, err := r.Accounts.Single(ctx, input.FromAccountId)
+		if err != nil {
+			return fmt.Errorf("transfer: %w", err)
+		}
+		toAccount, err := r.Accounts.Single(ctx, input.ToAccountId)
+		if err != nil {
+			return fmt.Errorf("transfer: %w", err)
+		}
+
+		fromPeriod, err := r.Periods.GetPeriod(ctx, fromAccount.Id, repo.ActivePeriodId)
+		if err != nil {
+			return fmt.Errorf("transfer: %w", err)
+		}
+		toPeriod, err := r.Periods.GetPeriod(ctx, toAccount.Id, repo.ActivePeriodId)
+		if err != nil {
+			return fmt.Errorf("transfer: %w", err)
+		}
+
+		transfer, err := r.Transfers.Add(ctx, domain.Transfer{
+			FromAccountId: fromAccount.Id,
+			ToAccountId:   toAccount.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("transfer: %w", err)
+		}
+
+		from, err = r.Transactions.Add(ctx, domain.Transaction{
+			AccountId:  fromAccount.Id,
+			PeriodId:   fromPeriod.Id,
+			Name:       transferName(input.Name, "Transfer to "+toAccount.Name),
+			Notes:      input.Notes,
+			Amount:     -input.Amount,
+			Date:       date,
+			CanDelete:  true,
+			TransferId: transfer.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("transfer: %w", err)
+		}
+
+		to, err = r.Transactions.Add(ctx, domain.Transaction{
+			AccountId:  toAccount.Id,
+			PeriodId:   toPeriod.Id,
+			Name:       transferName(input.Name, "Transfer from "+fromAccount.Name),
+			Notes:      input.Notes,
+			Amount:     input.Amount,
+			Date:       date,
+			CanDelete:  true,
+			TransferId: transfer.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("transfer: %w", err)
+		}
+
+		return nil
+	})
+
+	return from, to, err
+}
+
+func transferName(name string, fallback string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fallback
+	}
+	return name
+}
+
+var ErrorNoPendingOccurrence = errors.New("no pending occurrence in period")
+var ErrorDateOutsidePeriod = errors.New("date is outside the period")
+var ErrorPeriodNotInAccount = errors.New("period does not belong to the account")
+
+// ApplyRecurring actualizes the recurring's earliest occurrence in the period
+// that is not yet accounted for. The transaction is dated on the occurrence
+// and uses the recurring's amount unless the input overrides them. An
+// overriding date must fall within the period. Pass periodId ActivePeriodId
+// (0) to apply to the account's active period.
+func (ts *TransactionService) ApplyRecurring(ctx context.Context, input types.ApplyRecurringInput) (domain.Transaction, error) {
+	var t domain.Transaction
+	err := ts.uow.Do(ctx, func(r repo.Repos) error {
+		rt, err := r.Recurrings.Single(ctx, input.RecurringId)
+		if err != nil {
+			return err
+		}
+
+		period, err := accountPeriod(ctx, r, rt.AccountId, input.PeriodId)
+		if err != nil {
+			return err
+		}
+
+		occurrences, err := r.Recurrings.Occurrences(ctx, rt, period.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, o := range occurrences {
+			if o.Actualized() {
+				continue
+			}
+
+			date := o.Date
+			if input.Date != 0 {
+				date = time.UnixMilli(input.Date).UTC()
+				if err := checkInPeriod(period, date); err != nil {
+					return err
+				}
+			}
+
+			amount := rt.Amount
+			if input.Amount != nil {
+				amount = *input.Amount
+			}
+
+			t, err = actualize(ctx, r, rt.Id, period.Id, o.Date, date, amount)
+			return err
+		}
+
+		return fmt.Errorf("recurring %d: %w", rt.Id, ErrorNoPendingOccurrence)
 	})
+	if err != nil {
+		return t, fmt.Errorf("apply recurring: %w", err)
+	}
+
+	return t, nil
+}
+
+// accountPeriod loads the account's period, resolving ActivePeriodId to the
+// active one. GetPeriod falls back to the active period when the id is not
+// one of the account's, so a mismatch is reported here instead.
+func accountPeriod(ctx context.Context, r repo.Repos, accountId int64, periodId int64) (domain.Period, error) {
+	period, err := r.Periods.GetPeriod(ctx, accountId, periodId)
+	if err != nil {
+		return period, err
+	}
+
+	if periodId != repo.ActivePeriodId && period.Id != periodId {
+		return period, fmt.Errorf("period %d, account %d: %w", periodId, accountId, ErrorPeriodNotInAccount)
+	}
+	return period, nil
+}
+
+// checkInPeriod makes sure date falls on one of the period's days.
+func checkInPeriod(period domain.Period, date time.Time) error {
+	day := domain.Noon(date)
+	if day.Before(domain.Noon(period.ReportingStart)) || day.After(domain.Noon(period.ReportingEnd)) {
+		return fmt.Errorf("%w: %s is not between %s and %s", ErrorDateOutsidePeriod,
+			day.Format(time.DateOnly), period.ReportingStart.Format(time.DateOnly), period.ReportingEnd.Format(time.DateOnly))
+	}
+	return nil
 }
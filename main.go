@@ -2,6 +2,10 @@ package main
 
 import (
 	"embed"
+	"flag"
+	"fmt"
+	"os"
+	"tjdickerson/sacbooks/server"
 
 	"github.com/wailsapp/wails/v2"
 	"github.com/wailsapp/wails/v2/pkg/options"
@@ -12,6 +16,14 @@ import (
 var assets embed.FS
 
 func main() {
+	pendingMigrations := flag.Bool("pending-migrations", false, "list the schema migrations startup would apply and exit")
+	flag.Parse()
+
+	if *pendingMigrations {
+		listPendingMigrations()
+		return
+	}
+
 	// Create an instance of the app structure
 	app := NewApp()
 
@@ -35,3 +47,21 @@ func main() {
 		println("Error:", err.Error())
 	}
 }
+
+// listPendingMigrations prints the migrations the next startup would apply
+// without touching the schema.
+func listPendingMigrations() {
+	pending, err := server.PendingMigrations(server.DatabasePath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+
+	if len(pending) == 0 {
+		fmt.Println("no pending migrations")
+		return
+	}
+	for _, m := range pending {
+		fmt.Printf("%d %s\n", m.Version, m.Name)
+	}
+}
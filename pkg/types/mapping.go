@@ -1,7 +1,9 @@
 package types
 
 import (
+	"time"
 	"tjdickerson/sacbooks/internal/domain"
+	"tjdickerson/sacbooks/internal/schema"
 )
 
 func MapTransactionListResult(in Result[[]Transaction]) TransactionListResult {
@@ -20,6 +22,52 @@ func MapTransactionResult(in Result[Transaction]) TransactionResult {
 	}
 }
 
+func MapTransactionSearchResult(in Result[TransactionSearch]) TransactionSearchResult {
+	return TransactionSearchResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapTransactionMatchListResult(in Result[[]TransactionMatch]) TransactionMatchListResult {
+	return TransactionMatchListResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapReconciliation(rec domain.Reconciliation) Reconciliation {
+	return Reconciliation{
+		Id:                   rec.Id,
+		AccountId:            rec.AccountId,
+		StatementDate:        rec.StatementDate.UnixMilli(),
+		DisplayStatementDate: rec.StatementDate.Format("Mon Jan 02 2006"),
+		StatementBalance:     rec.StatementBalance,
+		ClearedBalance:       rec.ClearedBalance,
+		Difference:           rec.Difference,
+		IsFinalized:          !rec.FinalizedOn.IsZero(),
+		Transactions:         MapTransactions(rec.Transactions),
+	}
+}
+
+func MapReconciliationResult(in Result[Reconciliation]) ReconciliationResult {
+	return ReconciliationResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapTransferResult(in Result[Transfer]) TransferResult {
+	return TransferResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
 func MapRecurringListResult(in Result[[]Recurring]) RecurringListResult {
 	return RecurringListResult{
 		Success: in.Success,
@@ -60,6 +108,14 @@ func MapPeriodResult(in Result[Period]) PeriodResult {
 	}
 }
 
+func MapPeriodSummaryListResult(in Result[[]PeriodSummary]) PeriodSummaryListResult {
+	return PeriodSummaryListResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
 func MapAccount(account domain.Account) Account {
 	return Account{
 		Id:             account.Id,
@@ -82,12 +138,45 @@ func MapAccounts(accounts []domain.Account) []Account {
 func MapTransaction(transaction domain.Transaction) Transaction {
 	return Transaction{
 		Id:          transaction.Id,
+		AccountId:   transaction.AccountId,
+		PeriodId:    transaction.PeriodId,
 		CategoryId:  transaction.CategoryId,
 		Date:        transaction.Date.UnixMilli(),
 		DisplayDate: transaction.Date.Format("Mon Jan 02"),
 		Amount:      transaction.Amount,
 		Name:        transaction.Name,
+		Notes:       transaction.Notes,
+		TransferId:  transaction.TransferId,
+		Status:      transaction.Status,
+		Splits:      MapTransactionSplits(transaction.Splits),
+	}
+}
+
+func MapTransactionSplits(splits []domain.TransactionSplit) []TransactionSplit {
+	out := make([]TransactionSplit, 0, len(splits))
+	for _, s := range splits {
+		out = append(out, TransactionSplit{
+			Id:         s.Id,
+			CategoryId: s.CategoryId,
+			Amount:     s.Amount,
+			Memo:       s.Memo,
+		})
 	}
+
+	return out
+}
+
+func MapTransactionMatches(matches []domain.TransactionMatch) []TransactionMatch {
+	out := make([]TransactionMatch, 0, len(matches))
+	for _, m := range matches {
+		out = append(out, TransactionMatch{
+			Transaction:   MapTransaction(m.Transaction),
+			NameHighlight: m.NameHighlight,
+			NotesSnippet:  m.NotesSnippet,
+			Rank:          m.Rank,
+		})
+	}
+	return out
 }
 
 func MapTransactions(transactions []domain.Transaction) []Transaction {
@@ -106,10 +195,69 @@ func MapRecurring(recurring domain.Recurring) Recurring {
 		Amount:            recurring.Amount,
 		CategoryId:        recurring.CategoryId,
 		Day:               recurring.Day,
+		Frequency:         recurring.Frequency,
+		Interval:          recurring.Interval,
+		AnchorDate:        unixMilli(recurring.Anchor),
+		EndDate:           unixMilli(recurring.Until),
+		Count:             recurring.Count,
+		Weekday:           int(recurring.Weekday),
+		AutoApply:         recurring.AutoApply,
 		AccountedInPeriod: recurring.AccountedInPeriod,
+		Occurrences:       MapRecurringOccurrences(recurring.Occurrences),
+	}
+}
+
+func MapRecurringVariances(actualized []domain.ActualizedRecurring) []RecurringVariance {
+	out := make([]RecurringVariance, 0, len(actualized))
+	for _, ar := range actualized {
+		date := ar.Occurrence
+		if date.IsZero() {
+			date = ar.Date
+		}
+
+		out = append(out, RecurringVariance{
+			Id:             ar.Id,
+			RecurringId:    ar.BasedOnId,
+			Name:           ar.Name,
+			OccurrenceDate: date.Format("Mon Jan 02"),
+			Expected:       ar.Amount,
+			Actual:         ar.ActualAmount,
+			Variance:       ar.ActualAmount - ar.Amount,
+		})
+	}
+
+	return out
+}
+
+func MapRecurringVarianceListResult(in Result[[]RecurringVariance]) RecurringVarianceListResult {
+	return RecurringVarianceListResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
 	}
 }
 
+func MapRecurringOccurrences(occurrences []domain.RecurringOccurrence) []RecurringOccurrence {
+	out := make([]RecurringOccurrence, 0, len(occurrences))
+	for _, o := range occurrences {
+		out = append(out, RecurringOccurrence{
+			Date:        o.Date.UnixMilli(),
+			DisplayDate: o.Date.Format("Mon Jan 02"),
+			Actualized:  o.Actualized(),
+		})
+	}
+
+	return out
+}
+
+// unixMilli maps the zero time to 0 rather than a large negative number.
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
 func MapRecurrings(recurrings []domain.Recurring) []Recurring {
 	out := make([]Recurring, 0, len(recurrings))
 	for _, recurring := range recurrings {
@@ -120,20 +268,62 @@ func MapRecurrings(recurrings []domain.Recurring) []Recurring {
 }
 
 func MapPeriod(period domain.Period) Period {
-	return Period{
+	out := Period{
 		Id:             period.Id,
 		ReportingStart: period.ReportingStart.Format("Mon Jan 02"),
 		ReportingEnd:   period.ReportingEnd.Format("Mon Jan 02"),
 		OpenedOn:       period.OpenedOn.Format("Mon Jan 02"),
 		Balance:        period.Balance,
+		ClearedBalance: period.ClearedBalance,
+		WorkingBalance: period.WorkingBalance,
+		AutoApplied:    MapTransactions(period.AutoApplied),
+	}
+
+	for _, t := range period.AutoApplied {
+		out.AutoAppliedTotal += t.Amount
+	}
+
+	return out
+}
+
+func MapPeriodSummary(period domain.PeriodSummary) PeriodSummary {
+	out := PeriodSummary{
+		Id:             period.Id,
+		ReportingStart: period.ReportingStart.Format("Mon Jan 02 2006"),
+		ReportingEnd:   period.ReportingEnd.Format("Mon Jan 02 2006"),
+		OpenedOn:       period.OpenedOn.Format("Mon Jan 02 2006"),
+		IsClosed:       !period.ClosedOn.IsZero(),
+		OpeningBalance: period.OpeningBalance,
+		TotalInflow:    period.Inflow,
+		TotalOutflow:   period.Outflow,
+		EndingBalance:  period.Balance,
+		ClearedBalance: period.ClearedBalance,
+		WorkingBalance: period.WorkingBalance,
 	}
+
+	if out.IsClosed {
+		out.ClosedOn = period.ClosedOn.Format("Mon Jan 02 2006")
+	}
+
+	return out
+}
+
+func MapPeriodSummaries(periods []domain.PeriodSummary) []PeriodSummary {
+	out := make([]PeriodSummary, 0, len(periods))
+	for _, period := range periods {
+		out = append(out, MapPeriodSummary(period))
+	}
+
+	return out
 }
 
 func MapCategory(category domain.Category) Category {
 	return Category{
-		Id:    category.Id,
-		Name:  category.Name,
-		Color: category.Color,
+		Id:       category.Id,
+		Name:     category.Name,
+		Color:    category.Color,
+		Rollover: category.Rollover,
+		Kind:     category.Kind,
 	}
 }
 
@@ -161,3 +351,459 @@ func MapCategoryListResult(in Result[[]Category]) CategoryListResult {
 		Data:    in.Object,
 	}
 }
+
+func MapCategoryTotals(totals []domain.CategoryTotal) []CategoryTotal {
+	out := make([]CategoryTotal, 0, len(totals))
+	for _, t := range totals {
+		out = append(out, CategoryTotal{
+			CategoryId: t.Id,
+			Name:       t.Name,
+			Color:      t.Color,
+			Amount:     t.Amount,
+			Count:      t.Count,
+		})
+	}
+
+	return out
+}
+
+func MapCategoryTotalListResult(in Result[[]CategoryTotal]) CategoryTotalListResult {
+	return CategoryTotalListResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapBudget(b domain.Budget) Budget {
+	return Budget{
+		Id:         b.Id,
+		PeriodId:   b.PeriodId,
+		CategoryId: b.CategoryId,
+		Amount:     b.Amount,
+	}
+}
+
+func MapBudgetResult(in Result[Budget]) BudgetResult {
+	return BudgetResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapBudgetReport(period domain.Period, lines []domain.BudgetLine) BudgetReport {
+	out := BudgetReport{
+		Period: MapPeriod(period),
+		Lines:  make([]BudgetLine, 0, len(lines)),
+	}
+
+	for _, l := range lines {
+		out.Lines = append(out.Lines, BudgetLine{
+			CategoryId: l.Id,
+			Name:       l.Name,
+			Color:      l.Color,
+			Rollover:   l.Rollover,
+			Budgeted:   l.Budgeted,
+			CarriedIn:  l.CarriedIn,
+			Available:  l.Available,
+			Spent:      l.Spent,
+			Remaining:  l.Remaining,
+			Percent:    l.Percent,
+		})
+		out.TotalBudgeted += l.Budgeted
+		out.TotalCarriedIn += l.CarriedIn
+		out.TotalAvailable += l.Available
+		out.TotalSpent += l.Spent
+		out.TotalRemaining += l.Remaining
+	}
+
+	return out
+}
+
+func MapBudgetReportResult(in Result[BudgetReport]) BudgetReportResult {
+	return BudgetReportResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapBudgetRollovers(history []domain.BudgetRollover) []BudgetRollover {
+	out := make([]BudgetRollover, 0, len(history))
+	for _, h := range history {
+		out = append(out, BudgetRollover{
+			Id:           h.Id,
+			CategoryId:   h.CategoryId,
+			FromPeriodId: h.FromPeriodId,
+			ToPeriodId:   h.ToPeriodId,
+			PeriodStart:  h.PeriodStart.UTC().Format("Mon Jan 02 2006"),
+			Amount:       h.Amount,
+		})
+	}
+
+	return out
+}
+
+func MapBudgetRolloverListResult(in Result[[]BudgetRollover]) BudgetRolloverListResult {
+	return BudgetRolloverListResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapImportProfile(p domain.ImportProfile) ImportProfile {
+	return ImportProfile{
+		Id:            p.Id,
+		Name:          p.Name,
+		Delimiter:     p.Delimiter,
+		DateFormat:    p.DateFormat,
+		HeaderRows:    p.HeaderRows,
+		DateColumn:    p.DateColumn,
+		NameColumn:    p.NameColumn,
+		AmountColumn:  p.AmountColumn,
+		DebitColumn:   p.DebitColumn,
+		CreditColumn:  p.CreditColumn,
+		NegateAmounts: p.NegateAmounts,
+	}
+}
+
+func MapImportProfiles(profiles []domain.ImportProfile) []ImportProfile {
+	out := make([]ImportProfile, 0, len(profiles))
+	for _, p := range profiles {
+		out = append(out, MapImportProfile(p))
+	}
+
+	return out
+}
+
+func MapImportProfileResult(in Result[ImportProfile]) ImportProfileResult {
+	return ImportProfileResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapImportProfileListResult(in Result[[]ImportProfile]) ImportProfileListResult {
+	return ImportProfileListResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapImportRow(row domain.ImportRow) ImportRow {
+	out := ImportRow{
+		Line:           row.Line,
+		PeriodId:       row.Transaction.PeriodId,
+		Amount:         row.Transaction.Amount,
+		Name:           row.Transaction.Name,
+		Notes:          row.Transaction.Notes,
+		CategoryId:     row.Transaction.CategoryId,
+		Category:       row.CategoryName,
+		ExternalId:     row.Transaction.ExternalId,
+		Splits:         make([]ImportSplit, 0, len(row.Splits)),
+		OpeningBalance: row.OpeningBalance,
+		Duplicate:      row.Duplicate,
+	}
+
+	for _, s := range row.Splits {
+		out.Splits = append(out.Splits, ImportSplit{Category: s.CategoryName, Amount: s.Amount, Memo: s.Memo})
+	}
+
+	if !row.Transaction.Date.IsZero() {
+		out.Date = row.Transaction.Date.UnixMilli()
+		out.DisplayDate = row.Transaction.Date.Format("Mon Jan 02")
+	}
+
+	if row.Err != nil {
+		out.Error = row.Err.Error()
+	}
+
+	return out
+}
+
+func MapImportRows(rows []domain.ImportRow) []ImportRow {
+	out := make([]ImportRow, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, MapImportRow(row))
+	}
+
+	return out
+}
+
+func MapImportRowListResult(in Result[[]ImportRow]) ImportRowListResult {
+	return ImportRowListResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapExportFile(file domain.ExportFile) ExportFile {
+	return ExportFile{
+		FileName: file.FileName,
+		Content:  file.Content,
+	}
+}
+
+func MapExportFileResult(in Result[ExportFile]) ExportFileResult {
+	return ExportFileResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapForecast(f domain.Forecast) Forecast {
+	out := Forecast{
+		AccountId:         f.AccountId,
+		StartBalance:      f.StartBalance,
+		EndBalance:        f.StartBalance,
+		LowestBalance:     f.LowestBalance,
+		LowestDate:        f.LowestDate.UnixMilli(),
+		DisplayLowestDate: f.LowestDate.Format("Mon Jan 02 2006"),
+		Days:              make([]ForecastDay, 0, len(f.Days)),
+	}
+
+	previous := f.StartBalance
+	for _, d := range f.Days {
+		day := ForecastDay{
+			Date:        d.Date.UnixMilli(),
+			DisplayDate: d.Date.Format("Mon Jan 02"),
+			Balance:     d.Balance,
+			Change:      d.Balance - previous,
+			Events:      make([]ForecastEvent, 0, len(d.Events)),
+		}
+		for _, e := range d.Events {
+			day.Events = append(day.Events, ForecastEvent{
+				RecurringId: e.RecurringId,
+				Name:        e.Name,
+				Amount:      e.Amount,
+			})
+		}
+
+		out.Days = append(out.Days, day)
+		out.EndBalance = d.Balance
+		previous = d.Balance
+	}
+
+	return out
+}
+
+func MapForecastResult(in Result[Forecast]) ForecastResult {
+	return ForecastResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapSpendingReport(r domain.SpendingReport) SpendingReport {
+	out := SpendingReport{
+		AccountId:    r.AccountId,
+		PeriodId:     r.PeriodId,
+		From:         r.From.Format("Mon Jan 02 2006"),
+		To:           r.To.Format("Mon Jan 02 2006"),
+		Categories:   make([]CategorySpending, 0, len(r.Categories)),
+		TotalInflow:  r.TotalInflow,
+		TotalOutflow: r.TotalOutflow,
+		Net:          r.TotalInflow + r.TotalOutflow,
+	}
+
+	for _, c := range r.Categories {
+		out.Categories = append(out.Categories, CategorySpending{
+			CategoryId: c.Id,
+			Name:       c.Name,
+			Color:      c.Color,
+			Inflow:     c.Inflow,
+			Outflow:    c.Outflow,
+			Net:        c.Inflow + c.Outflow,
+			Count:      c.Count,
+			Share:      c.Share,
+		})
+	}
+
+	return out
+}
+
+func MapSpendingReportResult(in Result[SpendingReport]) SpendingReportResult {
+	return SpendingReportResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapIncomeExpenseReport(r domain.IncomeExpenseReport) IncomeExpenseReport {
+	out := IncomeExpenseReport{
+		AccountId: r.AccountId,
+		PeriodId:  r.PeriodId,
+		From:      r.From.Format("Mon Jan 02 2006"),
+		To:        r.To.Format("Mon Jan 02 2006"),
+		Periods:   make([]IncomeExpense, 0, len(r.Periods)),
+		Years:     make([]IncomeExpense, 0, len(r.Years)),
+		Total:     mapIncomeExpense(r.Total),
+	}
+
+	for _, p := range r.Periods {
+		ie := mapIncomeExpense(p)
+		ie.ReportingStart = p.Start.Format("Mon Jan 02 2006")
+		ie.ReportingEnd = p.End.Format("Mon Jan 02 2006")
+		out.Periods = append(out.Periods, ie)
+	}
+
+	for _, y := range r.Years {
+		out.Years = append(out.Years, mapIncomeExpense(y))
+	}
+
+	return out
+}
+
+func mapIncomeExpense(ie domain.IncomeExpense) IncomeExpense {
+	return IncomeExpense{
+		PeriodId:    ie.PeriodId,
+		Year:        ie.Year,
+		Income:      ie.Income,
+		Expense:     ie.Expense,
+		Savings:     ie.Savings,
+		SavingsRate: ie.SavingsRate,
+	}
+}
+
+func MapIncomeExpenseReportResult(in Result[IncomeExpenseReport]) IncomeExpenseReportResult {
+	return IncomeExpenseReportResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapTrendReport(r domain.TrendReport) TrendReport {
+	out := TrendReport{
+		AccountId:  r.AccountId,
+		Periods:    make([]TrendPeriod, 0, len(r.Periods)),
+		Categories: make([]CategoryTrend, 0, len(r.Categories)),
+	}
+
+	for _, p := range r.Periods {
+		out.Periods = append(out.Periods, TrendPeriod{
+			Id:             p.Id,
+			ReportingStart: p.ReportingStart.Format("Mon Jan 02 2006"),
+			ReportingEnd:   p.ReportingEnd.Format("Mon Jan 02 2006"),
+		})
+	}
+
+	for _, c := range r.Categories {
+		out.Categories = append(out.Categories, CategoryTrend{
+			CategoryId: c.Id,
+			Name:       c.Name,
+			Color:      c.Color,
+			Spent:      c.Spent,
+			Deltas:     c.Deltas,
+			Total:      c.Total,
+			Average:    c.Average,
+			Min:        c.Min,
+			Max:        c.Max,
+		})
+	}
+
+	return out
+}
+
+func MapTrendReportResult(in Result[TrendReport]) TrendReportResult {
+	return TrendReportResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapNetWorth(w domain.NetWorth) NetWorth {
+	out := NetWorth{
+		ByPeriod: w.ByPeriod,
+		Points:   make([]NetWorthPoint, 0, len(w.Points)),
+	}
+
+	for _, p := range w.Points {
+		point := NetWorthPoint{
+			Date:        p.Date.UnixMilli(),
+			DisplayDate: p.Date.Format("Mon Jan 02 2006"),
+			Total:       p.Total,
+			Accounts:    make([]AccountBalance, 0, len(p.Accounts)),
+		}
+		for _, a := range p.Accounts {
+			point.Accounts = append(point.Accounts, AccountBalance{
+				AccountId: a.AccountId,
+				Name:      a.Name,
+				Balance:   a.Balance,
+			})
+		}
+		out.Points = append(out.Points, point)
+	}
+
+	return out
+}
+
+func MapNetWorthResult(in Result[NetWorth]) NetWorthResult {
+	return NetWorthResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapBalanceSeries(s domain.BalanceSeries) BalanceSeries {
+	out := BalanceSeries{
+		AccountId:      s.AccountId,
+		Period:         MapPeriod(s.Period),
+		OpeningBalance: s.OpeningBalance,
+		Entries:        make([]BalanceEntry, 0, len(s.Entries)),
+		Days:           make([]DailyBalance, 0, len(s.Days)),
+	}
+
+	for _, e := range s.Entries {
+		out.Entries = append(out.Entries, BalanceEntry{
+			Transaction: MapTransaction(e.Transaction),
+			Balance:     e.Balance,
+		})
+	}
+
+	for _, d := range s.Days {
+		out.Days = append(out.Days, DailyBalance{
+			Date:        d.Date.UnixMilli(),
+			DisplayDate: d.Date.Format("Mon Jan 02"),
+			Balance:     d.Balance,
+			Change:      d.Change,
+			Count:       d.Count,
+		})
+	}
+
+	return out
+}
+
+func MapBalanceSeriesResult(in Result[BalanceSeries]) BalanceSeriesResult {
+	return BalanceSeriesResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
+
+func MapMigrations(migrations []schema.Migration) []Migration {
+	out := make([]Migration, 0, len(migrations))
+	for _, m := range migrations {
+		out = append(out, Migration{Version: m.Version, Name: m.Name})
+	}
+	return out
+}
+
+func MapMigrationListResult(in Result[[]Migration]) MigrationListResult {
+	return MigrationListResult{
+		Success: in.Success,
+		Message: in.Message,
+		Data:    in.Object,
+	}
+}
@@ -32,30 +32,153 @@ type TransactionListResult struct {
 }
 
 type Transaction struct {
-	Id              int64  `json:"id"`
-	CategoryId      int64  `json:"category_id"`
-	Date            int64  `json:"date"`
-	DisplayDate     string `json:"display_date"`
-	Amount          int64  `json:"amount"`
-	Name            string `json:"name"`
-	FromRecurringId int64  `json:"from_recurring_id"`
+	Id              int64              `json:"id"`
+	AccountId       int64              `json:"account_id"`
+	PeriodId        int64              `json:"period_id"`
+	CategoryId      int64              `json:"category_id"`
+	Date            int64              `json:"date"`
+	DisplayDate     string             `json:"display_date"`
+	Amount          int64              `json:"amount"`
+	Name            string             `json:"name"`
+	Notes           string             `json:"notes"`
+	FromRecurringId int64              `json:"from_recurring_id"`
+	TransferId      int64              `json:"transfer_id"`
+	Status          string             `json:"status"`
+	Splits          []TransactionSplit `json:"splits"`
 }
 
-type TransactionUpdateInput struct {
+type TransactionStatusInput struct {
+	Id     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+type ReconciliationInput struct {
+	AccountId        int64 `json:"account_id"`
+	StatementDate    int64 `json:"statement_date"`
+	StatementBalance int64 `json:"statement_balance"`
+}
+
+type Reconciliation struct {
+	Id                   int64         `json:"id"`
+	AccountId            int64         `json:"account_id"`
+	StatementDate        int64         `json:"statement_date"`
+	DisplayStatementDate string        `json:"display_statement_date"`
+	StatementBalance     int64         `json:"statement_balance"`
+	ClearedBalance       int64         `json:"cleared_balance"`
+	Difference           int64         `json:"difference"`
+	IsFinalized          bool          `json:"is_finalized"`
+	Transactions         []Transaction `json:"transactions"`
+}
+
+type ReconciliationResult struct {
+	Success bool           `json:"success"`
+	Message string         `json:"message"`
+	Data    Reconciliation `json:"data"`
+}
+
+type TransferInput struct {
+	FromAccountId int64  `json:"from_account_id"`
+	ToAccountId   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	Date          int64  `json:"date"`
+	Name          string `json:"name"`
+	Notes         string `json:"notes"`
+}
+
+type Transfer struct {
+	Id   int64       `json:"id"`
+	From Transaction `json:"from"`
+	To   Transaction `json:"to"`
+}
+
+type TransferResult struct {
+	Success bool     `json:"success"`
+	Message string   `json:"message"`
+	Data    Transfer `json:"data"`
+}
+
+type TransactionSplit struct {
 	Id         int64  `json:"id"`
-	Date       int64  `json:"date"`
-	Amount     int64  `json:"amount"`
 	CategoryId int64  `json:"category_id"`
-	Name       string `json:"name"`
+	Amount     int64  `json:"amount"`
+	Memo       string `json:"memo"`
 }
 
-type TransactionInsertInput struct {
-	AccountId  int64  `json:"account_id"`
-	PeriodId   int64  `json:"period_id"`
+type TransactionSplitInput struct {
 	CategoryId int64  `json:"category_id"`
-	Date       int64  `json:"date"`
 	Amount     int64  `json:"amount"`
-	Name       string `json:"name"`
+	Memo       string `json:"memo"`
+}
+
+type TransactionSearchInput struct {
+	AccountId   int64   `json:"account_id"`
+	Text        string  `json:"text"`
+	MinAmount   *int64  `json:"min_amount"`
+	MaxAmount   *int64  `json:"max_amount"`
+	StartDate   int64   `json:"start_date"`
+	EndDate     int64   `json:"end_date"`
+	CategoryIds []int64 `json:"category_ids"`
+	Origin      string  `json:"origin"`
+	SortBy      string  `json:"sort_by"`
+	SortDesc    bool    `json:"sort_desc"`
+	Limit       int     `json:"limit"`
+	Offset      int     `json:"offset"`
+}
+
+type TransactionSearch struct {
+	Transactions []Transaction `json:"transactions"`
+	TotalCount   int           `json:"total_count"`
+}
+
+type TransactionSearchResult struct {
+	Success bool              `json:"success"`
+	Message string            `json:"message"`
+	Data    TransactionSearch `json:"data"`
+}
+
+type FullTextSearchInput struct {
+	AccountId int64  `json:"account_id"`
+	Query     string `json:"query"`
+	Limit     int    `json:"limit"`
+	Offset    int    `json:"offset"`
+}
+
+type TransactionMatch struct {
+	Transaction   Transaction `json:"transaction"`
+	NameHighlight string      `json:"name_highlight"`
+	NotesSnippet  string      `json:"notes_snippet"`
+	Rank          float64     `json:"rank"`
+}
+
+type TransactionMatchListResult struct {
+	Success bool               `json:"success"`
+	Message string             `json:"message"`
+	Data    []TransactionMatch `json:"data"`
+}
+
+// TransactionUpdateInput leaves the stored notes alone when Notes is nil and
+// the stored splits alone when Splits is nil. An empty Splits list or
+// ClearSplits turns a split transaction back into a plain one.
+type TransactionUpdateInput struct {
+	Id          int64                   `json:"id"`
+	Date        int64                   `json:"date"`
+	Amount      int64                   `json:"amount"`
+	CategoryId  int64                   `json:"category_id"`
+	Name        string                  `json:"name"`
+	Notes       *string                 `json:"notes,omitempty"`
+	Splits      []TransactionSplitInput `json:"splits,omitempty"`
+	ClearSplits bool                    `json:"clear_splits"`
+}
+
+type TransactionInsertInput struct {
+	AccountId  int64                   `json:"account_id"`
+	PeriodId   int64                   `json:"period_id"`
+	CategoryId int64                   `json:"category_id"`
+	Date       int64                   `json:"date"`
+	Amount     int64                   `json:"amount"`
+	Name       string                  `json:"name"`
+	Notes      string                  `json:"notes"`
+	Splits     []TransactionSplitInput `json:"splits"`
 }
 
 type Period struct {
@@ -64,6 +187,33 @@ type Period struct {
 	ReportingEnd   string `json:"reporting_end"`
 	OpenedOn       string `json:"opened_on"`
 	Balance        int64  `json:"balance"`
+	ClearedBalance int64  `json:"cleared_balance"`
+	WorkingBalance int64  `json:"working_balance"`
+	// AutoApplied and AutoAppliedTotal summarize the recurrings applied when
+	// the period was opened.
+	AutoApplied      []Transaction `json:"auto_applied"`
+	AutoAppliedTotal int64         `json:"auto_applied_total"`
+}
+
+type PeriodSummary struct {
+	Id             int64  `json:"id"`
+	ReportingStart string `json:"reporting_start"`
+	ReportingEnd   string `json:"reporting_end"`
+	OpenedOn       string `json:"opened_on"`
+	ClosedOn       string `json:"closed_on"`
+	IsClosed       bool   `json:"is_closed"`
+	OpeningBalance int64  `json:"opening_balance"`
+	TotalInflow    int64  `json:"total_inflow"`
+	TotalOutflow   int64  `json:"total_outflow"`
+	EndingBalance  int64  `json:"ending_balance"`
+	ClearedBalance int64  `json:"cleared_balance"`
+	WorkingBalance int64  `json:"working_balance"`
+}
+
+type PeriodSummaryListResult struct {
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Data    []PeriodSummary `json:"data"`
 }
 
 type PeriodResult struct {
@@ -98,12 +248,54 @@ type AccountUpdateInput struct {
 }
 
 type Recurring struct {
-	Id                int64  `json:"id"`
-	Name              string `json:"name"`
-	Amount            int64  `json:"amount"`
-	CategoryId        int64  `json:"category_id"`
-	Day               uint8  `json:"day"`
-	AccountedInPeriod bool   `json:"accounted_for"`
+	Id                int64                 `json:"id"`
+	Name              string                `json:"name"`
+	Amount            int64                 `json:"amount"`
+	CategoryId        int64                 `json:"category_id"`
+	Day               uint8                 `json:"day"`
+	Frequency         string                `json:"frequency"`
+	Interval          int                   `json:"interval"`
+	AnchorDate        int64                 `json:"anchor_date"`
+	EndDate           int64                 `json:"end_date"`
+	Count             int                   `json:"count"`
+	Weekday           int                   `json:"weekday"`
+	AutoApply         bool                  `json:"auto_apply"`
+	AccountedInPeriod bool                  `json:"accounted_for"`
+	Occurrences       []RecurringOccurrence `json:"occurrences"`
+}
+
+type RecurringOccurrence struct {
+	Date        int64  `json:"date"`
+	DisplayDate string `json:"display_date"`
+	Actualized  bool   `json:"actualized"`
+}
+
+// ApplyRecurringInput actualizes a recurring's next pending occurrence in the
+// period. Amount overrides the recurring's amount when set and Date, in unix
+// milliseconds, overrides the occurrence date when not 0.
+type ApplyRecurringInput struct {
+	RecurringId int64  `json:"recurring_id"`
+	PeriodId    int64  `json:"period_id"`
+	Amount      *int64 `json:"amount,omitempty"`
+	Date        int64  `json:"date"`
+}
+
+// RecurringVariance compares what an actualized recurring was expected to
+// come to with what it did. Variance is Actual - Expected.
+type RecurringVariance struct {
+	Id             int64  `json:"id"`
+	RecurringId    int64  `json:"recurring_id"`
+	Name           string `json:"name"`
+	OccurrenceDate string `json:"occurrence_date"`
+	Expected       int64  `json:"expected"`
+	Actual         int64  `json:"actual"`
+	Variance       int64  `json:"variance"`
+}
+
+type RecurringVarianceListResult struct {
+	Success bool                `json:"success"`
+	Message string              `json:"message"`
+	Data    []RecurringVariance `json:"data"`
 }
 
 type RecurringResult struct {
@@ -118,18 +310,31 @@ type RecurringListResult struct {
 	Data    []Recurring `json:"data"`
 }
 
+// RecurringInput describes a recurring and its recurrence rule. An empty
+// Frequency on update keeps the existing rule, as does a nil AutoApply. Dates
+// are unix milliseconds and 0 leaves them unset; Weekday defaults to the
+// anchor date's weekday.
 type RecurringInput struct {
 	Id         int64  `json:"id"`
 	Amount     int64  `json:"amount"`
 	CategoryId int64  `json:"category_id"`
 	Name       string `json:"name"`
 	Day        uint8  `json:"day"`
+	Frequency  string `json:"frequency"`
+	Interval   int    `json:"interval"`
+	AnchorDate int64  `json:"anchor_date"`
+	EndDate    *int64 `json:"end_date,omitempty"`
+	Count      *int   `json:"count,omitempty"`
+	Weekday    *int   `json:"weekday,omitempty"`
+	AutoApply  *bool  `json:"auto_apply,omitempty"`
 }
 
 type Category struct {
-	Id    int64  `json:"id"`
-	Name  string `json:"name"`
-	Color string `json:"color"`
+	Id       int64  `json:"id"`
+	Name     string `json:"name"`
+	Color    string `json:"color"`
+	Rollover bool   `json:"rollover"`
+	Kind     string `json:"kind"`
 }
 
 type CategoryResult struct {
@@ -144,13 +349,414 @@ type CategoryListResult struct {
 	Data    []Category `json:"data"`
 }
 
+type CategoryTotal struct {
+	CategoryId int64  `json:"category_id"`
+	Name       string `json:"name"`
+	Color      string `json:"color"`
+	Amount     int64  `json:"amount"`
+	Count      int    `json:"count"`
+}
+
+type CategoryTotalListResult struct {
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Data    []CategoryTotal `json:"data"`
+}
+
+type BudgetInput struct {
+	AccountId  int64 `json:"account_id"`
+	PeriodId   int64 `json:"period_id"`
+	CategoryId int64 `json:"category_id"`
+	Amount     int64 `json:"amount"`
+}
+
+type Budget struct {
+	Id         int64 `json:"id"`
+	PeriodId   int64 `json:"period_id"`
+	CategoryId int64 `json:"category_id"`
+	Amount     int64 `json:"amount"`
+}
+
+type BudgetResult struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Data    Budget `json:"data"`
+}
+
+type BudgetLine struct {
+	CategoryId int64   `json:"category_id"`
+	Name       string  `json:"name"`
+	Color      string  `json:"color"`
+	Rollover   bool    `json:"rollover"`
+	Budgeted   int64   `json:"budgeted"`
+	CarriedIn  int64   `json:"carried_in"`
+	Available  int64   `json:"available"`
+	Spent      int64   `json:"spent"`
+	Remaining  int64   `json:"remaining"`
+	Percent    float64 `json:"percent"`
+}
+
+type BudgetReport struct {
+	Period         Period       `json:"period"`
+	Lines          []BudgetLine `json:"lines"`
+	TotalBudgeted  int64        `json:"total_budgeted"`
+	TotalCarriedIn int64        `json:"total_carried_in"`
+	TotalAvailable int64        `json:"total_available"`
+	TotalSpent     int64        `json:"total_spent"`
+	TotalRemaining int64        `json:"total_remaining"`
+}
+
+type BudgetReportResult struct {
+	Success bool         `json:"success"`
+	Message string       `json:"message"`
+	Data    BudgetReport `json:"data"`
+}
+
+type BudgetRollover struct {
+	Id           int64  `json:"id"`
+	CategoryId   int64  `json:"category_id"`
+	FromPeriodId int64  `json:"from_period_id"`
+	ToPeriodId   int64  `json:"to_period_id"`
+	PeriodStart  string `json:"period_start"`
+	Amount       int64  `json:"amount"`
+}
+
+type BudgetRolloverListResult struct {
+	Success bool             `json:"success"`
+	Message string           `json:"message"`
+	Data    []BudgetRollover `json:"data"`
+}
+
+// Kind is "auto", "income" or "expense". Omitted fields default to no
+// rollover and auto on insert and are left unchanged on update.
 type CategoryInsertInput struct {
-	Name  string `json:"name"`
-	Color string `json:"color"`
+	Name     string `json:"name"`
+	Color    string `json:"color"`
+	Rollover *bool  `json:"rollover,omitempty"`
+	Kind     string `json:"kind"`
 }
 
 type CategoryUpdateInput struct {
-	Id    int64  `json:"id"`
-	Name  string `json:"name"`
-	Color string `json:"color"`
+	Id       int64  `json:"id"`
+	Name     string `json:"name"`
+	Color    string `json:"color"`
+	Rollover *bool  `json:"rollover,omitempty"`
+	Kind     string `json:"kind"`
+}
+
+type ImportProfile struct {
+	Id            int64  `json:"id"`
+	Name          string `json:"name"`
+	Delimiter     string `json:"delimiter"`
+	DateFormat    string `json:"date_format"`
+	HeaderRows    int    `json:"header_rows"`
+	DateColumn    int    `json:"date_column"`
+	NameColumn    int    `json:"name_column"`
+	AmountColumn  int    `json:"amount_column"`
+	DebitColumn   int    `json:"debit_column"`
+	CreditColumn  int    `json:"credit_column"`
+	NegateAmounts bool   `json:"negate_amounts"`
+}
+
+type ImportProfileResult struct {
+	Success bool          `json:"success"`
+	Message string        `json:"message"`
+	Data    ImportProfile `json:"data"`
+}
+
+type ImportProfileListResult struct {
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Data    []ImportProfile `json:"data"`
+}
+
+type ImportProfileInput struct {
+	Id            int64  `json:"id"`
+	Name          string `json:"name"`
+	Delimiter     string `json:"delimiter"`
+	DateFormat    string `json:"date_format"`
+	HeaderRows    int    `json:"header_rows"`
+	DateColumn    int    `json:"date_column"`
+	NameColumn    int    `json:"name_column"`
+	AmountColumn  int    `json:"amount_column"`
+	DebitColumn   int    `json:"debit_column"`
+	CreditColumn  int    `json:"credit_column"`
+	NegateAmounts bool   `json:"negate_amounts"`
+}
+
+type CSVImportInput struct {
+	AccountId  int64  `json:"account_id"`
+	ProfileId  int64  `json:"profile_id"`
+	CategoryId int64  `json:"category_id"`
+	FileName   string `json:"file_name"`
+	Content    string `json:"content"`
+}
+
+type FileImportInput struct {
+	AccountId  int64  `json:"account_id"`
+	CategoryId int64  `json:"category_id"`
+	FileName   string `json:"file_name"`
+	Content    string `json:"content"`
+}
+
+type ImportRow struct {
+	Line           int           `json:"line"`
+	PeriodId       int64         `json:"period_id"`
+	Date           int64         `json:"date"`
+	DisplayDate    string        `json:"display_date"`
+	Amount         int64         `json:"amount"`
+	Name           string        `json:"name"`
+	Notes          string        `json:"notes"`
+	CategoryId     int64         `json:"category_id"`
+	Category       string        `json:"category"`
+	ExternalId     string        `json:"external_id"`
+	Splits         []ImportSplit `json:"splits"`
+	OpeningBalance bool          `json:"opening_balance"`
+	Duplicate      bool          `json:"duplicate"`
+	Error          string        `json:"error"`
+}
+
+type ImportSplit struct {
+	Category string `json:"category"`
+	Amount   int64  `json:"amount"`
+	Memo     string `json:"memo"`
+}
+
+type ImportRowListResult struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message"`
+	Data    []ImportRow `json:"data"`
+}
+
+type QIFImportInput struct {
+	AccountId  int64  `json:"account_id"`
+	CategoryId int64  `json:"category_id"`
+	FileName   string `json:"file_name"`
+	DateFormat string `json:"date_format"`
+	Content    string `json:"content"`
+}
+
+type PeriodRangeInput struct {
+	AccountId    int64 `json:"account_id"`
+	FromPeriodId int64 `json:"from_period_id"`
+	ToPeriodId   int64 `json:"to_period_id"`
+}
+
+type JournalExportInput struct {
+	AccountId int64  `json:"account_id"`
+	StartDate int64  `json:"start_date"`
+	EndDate   int64  `json:"end_date"`
+	Format    string `json:"format"`
+}
+
+type ExportFile struct {
+	FileName string `json:"file_name"`
+	Content  string `json:"content"`
+}
+
+type ExportFileResult struct {
+	Success bool       `json:"success"`
+	Message string     `json:"message"`
+	Data    ExportFile `json:"data"`
+}
+
+// ForecastInput asks for a forecast of the account's balance over the next
+// Days days, 0 for the default horizon.
+type ForecastInput struct {
+	AccountId int64 `json:"account_id"`
+	Days      int   `json:"days"`
+}
+
+type ForecastEvent struct {
+	RecurringId int64  `json:"recurring_id"`
+	Name        string `json:"name"`
+	Amount      int64  `json:"amount"`
+}
+
+type ForecastDay struct {
+	Date        int64           `json:"date"`
+	DisplayDate string          `json:"display_date"`
+	Balance     int64           `json:"balance"`
+	Change      int64           `json:"change"`
+	Events      []ForecastEvent `json:"events"`
+}
+
+type Forecast struct {
+	AccountId         int64         `json:"account_id"`
+	StartBalance      int64         `json:"start_balance"`
+	EndBalance        int64         `json:"end_balance"`
+	LowestBalance     int64         `json:"lowest_balance"`
+	LowestDate        int64         `json:"lowest_date"`
+	DisplayLowestDate string        `json:"display_lowest_date"`
+	Days              []ForecastDay `json:"days"`
+}
+
+type ForecastResult struct {
+	Success bool     `json:"success"`
+	Message string   `json:"message"`
+	Data    Forecast `json:"data"`
+}
+
+// ReportRangeInput selects what a report covers. From and To are unix
+// milliseconds and select an inclusive date range across periods; when both
+// are 0 the report covers PeriodId, or the active period when it is 0.
+type ReportRangeInput struct {
+	AccountId int64 `json:"account_id"`
+	PeriodId  int64 `json:"period_id"`
+	From      int64 `json:"from"`
+	To        int64 `json:"to"`
+}
+
+type CategorySpending struct {
+	CategoryId int64   `json:"category_id"`
+	Name       string  `json:"name"`
+	Color      string  `json:"color"`
+	Inflow     int64   `json:"inflow"`
+	Outflow    int64   `json:"outflow"`
+	Net        int64   `json:"net"`
+	Count      int     `json:"count"`
+	Share      float64 `json:"share"`
+}
+
+type SpendingReport struct {
+	AccountId    int64              `json:"account_id"`
+	PeriodId     int64              `json:"period_id"`
+	From         string             `json:"from"`
+	To           string             `json:"to"`
+	Categories   []CategorySpending `json:"categories"`
+	TotalInflow  int64              `json:"total_inflow"`
+	TotalOutflow int64              `json:"total_outflow"`
+	Net          int64              `json:"net"`
+}
+
+type SpendingReportResult struct {
+	Success bool           `json:"success"`
+	Message string         `json:"message"`
+	Data    SpendingReport `json:"data"`
+}
+
+type IncomeExpense struct {
+	PeriodId       int64   `json:"period_id"`
+	ReportingStart string  `json:"reporting_start"`
+	ReportingEnd   string  `json:"reporting_end"`
+	Year           int     `json:"year"`
+	Income         int64   `json:"income"`
+	Expense        int64   `json:"expense"`
+	Savings        int64   `json:"savings"`
+	SavingsRate    float64 `json:"savings_rate"`
+}
+
+type IncomeExpenseReport struct {
+	AccountId int64           `json:"account_id"`
+	PeriodId  int64           `json:"period_id"`
+	From      string          `json:"from"`
+	To        string          `json:"to"`
+	Periods   []IncomeExpense `json:"periods"`
+	Years     []IncomeExpense `json:"years"`
+	Total     IncomeExpense   `json:"total"`
+}
+
+type IncomeExpenseReportResult struct {
+	Success bool                `json:"success"`
+	Message string              `json:"message"`
+	Data    IncomeExpenseReport `json:"data"`
+}
+
+// TrendInput asks for the trend over the account's most recent Periods
+// periods, 0 for the default.
+type TrendInput struct {
+	AccountId int64 `json:"account_id"`
+	Periods   int   `json:"periods"`
+}
+
+type TrendPeriod struct {
+	Id             int64  `json:"id"`
+	ReportingStart string `json:"reporting_start"`
+	ReportingEnd   string `json:"reporting_end"`
+}
+
+type CategoryTrend struct {
+	CategoryId int64   `json:"category_id"`
+	Name       string  `json:"name"`
+	Color      string  `json:"color"`
+	Spent      []int64 `json:"spent"`
+	Deltas     []int64 `json:"deltas"`
+	Total      int64   `json:"total"`
+	Average    float64 `json:"average"`
+	Min        int64   `json:"min"`
+	Max        int64   `json:"max"`
+}
+
+type TrendReport struct {
+	AccountId  int64           `json:"account_id"`
+	Periods    []TrendPeriod   `json:"periods"`
+	Categories []CategoryTrend `json:"categories"`
+}
+
+type TrendReportResult struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message"`
+	Data    TrendReport `json:"data"`
+}
+
+type AccountBalance struct {
+	AccountId int64  `json:"account_id"`
+	Name      string `json:"name"`
+	Balance   int64  `json:"balance"`
+}
+
+type NetWorthPoint struct {
+	Date        int64            `json:"date"`
+	DisplayDate string           `json:"display_date"`
+	Total       int64            `json:"total"`
+	Accounts    []AccountBalance `json:"accounts"`
+}
+
+type NetWorth struct {
+	ByPeriod bool            `json:"by_period"`
+	Points   []NetWorthPoint `json:"points"`
+}
+
+type NetWorthResult struct {
+	Success bool     `json:"success"`
+	Message string   `json:"message"`
+	Data    NetWorth `json:"data"`
+}
+
+type BalanceEntry struct {
+	Transaction Transaction `json:"transaction"`
+	Balance     int64       `json:"balance"`
+}
+
+type DailyBalance struct {
+	Date        int64  `json:"date"`
+	DisplayDate string `json:"display_date"`
+	Balance     int64  `json:"balance"`
+	Change      int64  `json:"change"`
+	Count       int    `json:"count"`
+}
+
+type BalanceSeries struct {
+	AccountId      int64          `json:"account_id"`
+	Period         Period         `json:"period"`
+	OpeningBalance int64          `json:"opening_balance"`
+	Entries        []BalanceEntry `json:"entries"`
+	Days           []DailyBalance `json:"days"`
+}
+
+type BalanceSeriesResult struct {
+	Success bool          `json:"success"`
+	Message string        `json:"message"`
+	Data    BalanceSeries `json:"data"`
+}
+
+type Migration struct {
+	Version int    `json:"version"`
+	Name    string `json:"name"`
+}
+
+type MigrationListResult struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message"`
+	Data    []Migration `json:"data"`
 }
@@ -5,6 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"log"
+	"sync"
+	"time"
 	"tjdickerson/sacbooks/internal/database"
 	"tjdickerson/sacbooks/internal/repo"
 	"tjdickerson/sacbooks/internal/schema"
@@ -13,16 +16,49 @@ import (
 )
 
 type Server struct {
-	db                 *sql.DB
-	transactionService *service.TransactionService
-	accountService     *service.AccountService
-	recurringService   *service.RecurringService
-	categoryService    *service.CategoryService
+	db                    *sql.DB
+	transactionService    *service.TransactionService
+	accountService        *service.AccountService
+	recurringService      *service.RecurringService
+	categoryService       *service.CategoryService
+	importService         *service.ImportService
+	exportService         *service.ExportService
+	ledgerService         *service.LedgerService
+	reconciliationService *service.ReconciliationService
+	budgetService         *service.BudgetService
+	forecastService       *service.ForecastService
+	reportService         *service.ReportService
+	startupMigrations     []schema.Migration
+	stopRollOver          context.CancelFunc
+	rollOverDone          sync.WaitGroup
+}
+
+// rollOverInterval is how often the running app checks for ended periods.
+const rollOverInterval = time.Hour
+
+// DatabasePath is the database file the app opens.
+const DatabasePath = "active.db"
+
+// PendingMigrations opens the database at dbPath without migrating it and
+// lists the schema migrations that the next Startup will apply.
+func PendingMigrations(dbPath string) ([]types.Migration, error) {
+	db, err := database.Startup(dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+	defer database.Shutdown(db)
+
+	pending, err := schema.Pending(context.Background(), db)
+	if err != nil {
+		return nil, fmt.Errorf("pending migrations: %w", err)
+	}
+
+	return types.MapMigrations(pending), nil
 }
 
 func (s *Server) Startup() {
 	ctx := context.Background()
-	dbPath := "active.db"
+	dbPath := DatabasePath
 
 	db, err := database.Startup(dbPath)
 
@@ -35,14 +71,32 @@ func (s *Server) Startup() {
 	accountRepo := repo.NewAccountRepo(db)
 	periodRepo := repo.NewPeriodRepo(db)
 	categoryRepo := repo.NewCategoryRepo(db)
+	importProfileRepo := repo.NewImportProfileRepo(db)
+	splitRepo := repo.NewTransactionSplitRepo(db)
+	transferRepo := repo.NewTransferRepo(db)
+	budgetRepo := repo.NewBudgetRepo(db)
+	reportRepo := repo.NewReportRepo(db)
+	uow := repo.NewUnitOfWork(db)
 
 	s.db = db
-	s.transactionService = service.NewTransactionService(transactionRepo, recurringRepo, accountRepo)
-	s.accountService = service.NewAccountService(accountRepo, periodRepo, transactionRepo, categoryRepo)
+	s.transactionService = service.NewTransactionService(uow, transactionRepo, splitRepo, recurringRepo, accountRepo)
+	s.accountService = service.NewAccountService(uow, accountRepo, periodRepo, transactionRepo, categoryRepo)
 	s.recurringService = service.NewRecurringService(recurringRepo)
 	s.categoryService = service.NewCategoryService(categoryRepo)
+	s.importService = service.NewImportService(uow, importProfileRepo, periodRepo, transactionRepo, categoryRepo)
+	s.exportService = service.NewExportService(accountRepo, transactionRepo, splitRepo, transferRepo, categoryRepo)
+	s.ledgerService = service.NewLedgerService(uow)
+	s.reconciliationService = service.NewReconciliationService(uow)
+	s.budgetService = service.NewBudgetService(uow, budgetRepo, periodRepo, categoryRepo)
+	s.forecastService = service.NewForecastService(uow)
+	s.reportService = service.NewReportService(reportRepo, periodRepo, accountRepo)
+
+	s.startupMigrations, err = schema.Pending(ctx, db)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to list pending migrations: %s", err))
+	}
 
-	err = schema.Ensure(ctx, db)
+	err = schema.Ensure(ctx, db, dbPath)
 	if err != nil && !errors.Is(err, schema.NoAccountError) {
 		panic(fmt.Sprintf("Failed to initialize new database: %s", err))
 	}
@@ -53,9 +107,48 @@ func (s *Server) Startup() {
 			panic(fmt.Sprintf("Failed to create default account: %s", err))
 		}
 	}
+
+	s.rollOver(ctx)
+
+	rollOverCtx, cancel := context.WithCancel(ctx)
+	s.stopRollOver = cancel
+	s.rollOverDone.Add(1)
+	go s.rollOverLoop(rollOverCtx)
+}
+
+func (s *Server) rollOverLoop(ctx context.Context) {
+	defer s.rollOverDone.Done()
+
+	ticker := time.NewTicker(rollOverInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.rollOver(ctx)
+		}
+	}
+}
+
+func (s *Server) rollOver(ctx context.Context) {
+	opened, err := s.accountService.RollOverPeriods(ctx, time.Now())
+	if err != nil {
+		log.Printf("error rolling over periods: %s\n", err)
+	}
+
+	for _, p := range opened {
+		log.Printf("opened period %d for account %d with %d recurrings applied\n", p.Id, p.AccountId, len(p.AutoApplied))
+	}
 }
 
 func (s *Server) Shutdown() {
+	if s.stopRollOver != nil {
+		s.stopRollOver()
+		s.rollOverDone.Wait()
+	}
+
 	err := database.Shutdown(s.db)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to shutdown database: %s", err))
@@ -73,6 +166,31 @@ func (s *Server) ListTransactions(accountId int64, periodId int64, limit int, of
 	return types.Ok(types.MapTransactions(transactions))
 }
 
+func (s *Server) SearchTransactions(input types.TransactionSearchInput) types.Result[types.TransactionSearch] {
+	ctx := context.Background()
+
+	transactions, total, err := s.transactionService.Search(ctx, input)
+	if err != nil {
+		return types.Fail[types.TransactionSearch](fmt.Sprintf("failed to search transactions: %s", err))
+	}
+
+	return types.Ok(types.TransactionSearch{
+		Transactions: types.MapTransactions(transactions),
+		TotalCount:   total,
+	})
+}
+
+func (s *Server) FullTextSearch(input types.FullTextSearchInput) types.Result[[]types.TransactionMatch] {
+	ctx := context.Background()
+
+	matches, err := s.transactionService.FullTextSearch(ctx, input)
+	if err != nil {
+		return types.Fail[[]types.TransactionMatch](fmt.Sprintf("failed to search transactions: %s", err))
+	}
+
+	return types.Ok(types.MapTransactionMatches(matches))
+}
+
 func (s *Server) GetAccountInfo(accountId int64) types.Result[types.Account] {
 	ctx := context.Background()
 
@@ -105,6 +223,17 @@ func (s *Server) AddRecurring(accountId int64, name string, amount int64, day ui
 	return types.Ok(types.MapRecurring(recurring))
 }
 
+func (s *Server) AddRecurringRule(accountId int64, input types.RecurringInput) types.Result[types.Recurring] {
+	ctx := context.Background()
+
+	recurring, err := s.recurringService.AddRule(ctx, accountId, input)
+	if err != nil {
+		return types.Fail[types.Recurring](fmt.Sprintf("adding recurring: %s", err))
+	}
+
+	return types.Ok(types.MapRecurring(recurring))
+}
+
 func (s *Server) AddTransaction(input types.TransactionInsertInput) types.Result[types.Transaction] {
 	ctx := context.Background()
 
@@ -139,10 +268,95 @@ func (s *Server) UpdateTransaction(input types.TransactionUpdateInput) types.Res
 	return types.Ok(types.MapTransaction(t))
 }
 
+func (s *Server) SetTransactionStatus(input types.TransactionStatusInput) types.Result[types.Transaction] {
+	ctx := context.Background()
+
+	t, err := s.transactionService.SetStatus(ctx, input.Id, input.Status)
+	if err != nil {
+		return types.Fail[types.Transaction](fmt.Sprintf("error setting transaction status: %s", err))
+	}
+
+	return types.Ok(types.MapTransaction(t))
+}
+
+func (s *Server) StartReconciliation(input types.ReconciliationInput) types.Result[types.Reconciliation] {
+	ctx := context.Background()
+
+	rec, err := s.reconciliationService.Start(ctx, input)
+	if err != nil {
+		return types.Fail[types.Reconciliation](fmt.Sprintf("failed to start reconciliation: %s", err))
+	}
+
+	return types.Ok(types.MapReconciliation(rec))
+}
+
+func (s *Server) GetOpenReconciliation(accountId int64) types.Result[types.Reconciliation] {
+	ctx := context.Background()
+
+	rec, err := s.reconciliationService.Open(ctx, accountId)
+	if err != nil {
+		return types.Fail[types.Reconciliation](fmt.Sprintf("failed to get reconciliation: %s", err))
+	}
+
+	return types.Ok(types.MapReconciliation(rec))
+}
+
+func (s *Server) SetReconciliationCleared(reconciliationId int64, transactionId int64, cleared bool) types.Result[types.Reconciliation] {
+	ctx := context.Background()
+
+	rec, err := s.reconciliationService.SetCleared(ctx, reconciliationId, transactionId, cleared)
+	if err != nil {
+		return types.Fail[types.Reconciliation](fmt.Sprintf("failed to update reconciliation: %s", err))
+	}
+
+	return types.Ok(types.MapReconciliation(rec))
+}
+
+func (s *Server) FinalizeReconciliation(reconciliationId int64) types.Result[types.Reconciliation] {
+	ctx := context.Background()
+
+	rec, err := s.reconciliationService.Finalize(ctx, reconciliationId)
+	if err != nil {
+		return types.Fail[types.Reconciliation](fmt.Sprintf("failed to finalize reconciliation: %s", err))
+	}
+
+	return types.Ok(types.MapReconciliation(rec))
+}
+
+func (s *Server) CancelReconciliation(reconciliationId int64) types.SimpleResult {
+	ctx := context.Background()
+
+	err := s.reconciliationService.Cancel(ctx, reconciliationId)
+	if err != nil {
+		return types.SimpleResult{Success: false, Message: fmt.Sprintf("failed to cancel reconciliation: %s", err)}
+	}
+
+	return types.SimpleResult{Success: true, Message: "Cancelled"}
+}
+
+func (s *Server) Transfer(input types.TransferInput) types.Result[types.Transfer] {
+	ctx := context.Background()
+
+	from, to, err := s.transactionService.Transfer(ctx, input)
+	if err != nil {
+		return types.Fail[types.Transfer](fmt.Sprintf("error transferring: %s", err))
+	}
+
+	return types.Ok(types.Transfer{
+		Id:   from.TransferId,
+		From: types.MapTransaction(from),
+		To:   types.MapTransaction(to),
+	})
+}
+
 func (s *Server) ApplyRecurring(recurringId int64, periodId int64) types.Result[types.Transaction] {
+	return s.ApplyRecurringWith(types.ApplyRecurringInput{RecurringId: recurringId, PeriodId: periodId})
+}
+
+func (s *Server) ApplyRecurringWith(input types.ApplyRecurringInput) types.Result[types.Transaction] {
 	ctx := context.Background()
 
-	t, err := s.transactionService.ApplyRecurring(ctx, recurringId, periodId)
+	t, err := s.transactionService.ApplyRecurring(ctx, input)
 	if err != nil {
 		return types.Fail[types.Transaction](fmt.Sprintf("applying recurring transaction: %s", err))
 	}
@@ -150,6 +364,17 @@ func (s *Server) ApplyRecurring(recurringId int64, periodId int64) types.Result[
 	return types.Ok(types.MapTransaction(t))
 }
 
+func (s *Server) GetRecurringVariance(accountId int64, periodId int64) types.Result[[]types.RecurringVariance] {
+	ctx := context.Background()
+
+	actualized, err := s.recurringService.Actualized(ctx, accountId, periodId)
+	if err != nil {
+		return types.Fail[[]types.RecurringVariance](fmt.Sprintf("recurring variance: %s", err))
+	}
+
+	return types.Ok(types.MapRecurringVariances(actualized))
+}
+
 func (s *Server) AddAccount(name string, periodStartDay uint8) types.Result[types.Account] {
 	ctx := context.Background()
 
@@ -207,6 +432,28 @@ func (s *Server) GetActivePeriod(accountId int64) types.Result[types.Period] {
 	return types.Ok(types.MapPeriod(result))
 }
 
+func (s *Server) ListPeriods(accountId int64) types.Result[[]types.PeriodSummary] {
+	ctx := context.Background()
+
+	list, err := s.accountService.ListPeriods(ctx, accountId)
+	if err != nil {
+		return types.Fail[[]types.PeriodSummary](fmt.Sprintf("error listing periods: %s", err))
+	}
+
+	return types.Ok(types.MapPeriodSummaries(list))
+}
+
+func (s *Server) CloseActivePeriod(accountId int64) types.Result[types.Period] {
+	ctx := context.Background()
+
+	result, err := s.accountService.CloseActivePeriod(ctx, accountId)
+	if err != nil {
+		return types.Fail[types.Period](fmt.Sprintf("error closing active period: %s", err))
+	}
+
+	return types.Ok(types.MapPeriod(result))
+}
+
 func (s *Server) UpdateAccount(accountId int64, input types.AccountUpdateInput) types.Result[types.Account] {
 	ctx := context.Background()
 
@@ -240,6 +487,17 @@ func (s *Server) ListCategories(accountId int64) types.Result[[]types.Category]
 	return types.Ok(types.MapCategories(list))
 }
 
+func (s *Server) GetCategoryTotals(accountId int64, periodId int64) types.Result[[]types.CategoryTotal] {
+	ctx := context.Background()
+
+	totals, err := s.categoryService.Totals(ctx, accountId, periodId)
+	if err != nil {
+		return types.Fail[[]types.CategoryTotal](fmt.Sprintf("category totals: %s", err))
+	}
+
+	return types.Ok(types.MapCategoryTotals(totals))
+}
+
 func (s *Server) AddCategory(accountId int64, input types.CategoryInsertInput) types.Result[types.Category] {
 	ctx := context.Background()
 
@@ -256,7 +514,7 @@ func (s *Server) UpdateCategory(accountId int64, input types.CategoryUpdateInput
 
 	c, err := s.categoryService.Update(ctx, accountId, input)
 	if err != nil {
-		return types.Fail[types.Category](fmt.Sprintf("add category: %s", err))
+		return types.Fail[types.Category](fmt.Sprintf("update category: %s", err))
 	}
 
 	return types.Ok(types.MapCategory(c))
@@ -272,3 +530,274 @@ func (s *Server) DeleteCategory(categoryId int64) types.SimpleResult {
 
 	return types.SimpleResult{Success: true, Message: "Deleted"}
 }
+
+func (s *Server) SetBudget(input types.BudgetInput) types.Result[types.Budget] {
+	ctx := context.Background()
+
+	b, err := s.budgetService.Set(ctx, input)
+	if err != nil {
+		return types.Fail[types.Budget](fmt.Sprintf("set budget: %s", err))
+	}
+
+	return types.Ok(types.MapBudget(b))
+}
+
+func (s *Server) CopyPreviousBudgets(accountId int64, periodId int64) types.Result[types.BudgetReport] {
+	ctx := context.Background()
+
+	period, lines, err := s.budgetService.CopyFromPrevious(ctx, accountId, periodId)
+	if err != nil {
+		return types.Fail[types.BudgetReport](fmt.Sprintf("copy budgets: %s", err))
+	}
+
+	return types.Ok(types.MapBudgetReport(period, lines))
+}
+
+func (s *Server) GetBudgetReport(accountId int64, periodId int64) types.Result[types.BudgetReport] {
+	ctx := context.Background()
+
+	period, lines, err := s.budgetService.BudgetVsActual(ctx, accountId, periodId)
+	if err != nil {
+		return types.Fail[types.BudgetReport](fmt.Sprintf("budget report: %s", err))
+	}
+
+	return types.Ok(types.MapBudgetReport(period, lines))
+}
+
+func (s *Server) GetRolloverHistory(categoryId int64) types.Result[[]types.BudgetRollover] {
+	ctx := context.Background()
+
+	history, err := s.budgetService.RolloverHistory(ctx, categoryId)
+	if err != nil {
+		return types.Fail[[]types.BudgetRollover](fmt.Sprintf("rollover history: %s", err))
+	}
+
+	return types.Ok(types.MapBudgetRollovers(history))
+}
+
+func (s *Server) GetForecast(input types.ForecastInput) types.Result[types.Forecast] {
+	ctx := context.Background()
+
+	forecast, err := s.forecastService.Forecast(ctx, input.AccountId, input.Days, time.Now())
+	if err != nil {
+		return types.Fail[types.Forecast](fmt.Sprintf("forecast: %s", err))
+	}
+
+	return types.Ok(types.MapForecast(forecast))
+}
+
+func (s *Server) GetSpendingByCategory(input types.ReportRangeInput) types.Result[types.SpendingReport] {
+	ctx := context.Background()
+
+	report, err := s.reportService.SpendingByCategory(ctx, input)
+	if err != nil {
+		return types.Fail[types.SpendingReport](fmt.Sprintf("spending report: %s", err))
+	}
+
+	return types.Ok(types.MapSpendingReport(report))
+}
+
+func (s *Server) GetIncomeExpense(input types.ReportRangeInput) types.Result[types.IncomeExpenseReport] {
+	ctx := context.Background()
+
+	report, err := s.reportService.IncomeExpense(ctx, input)
+	if err != nil {
+		return types.Fail[types.IncomeExpenseReport](fmt.Sprintf("income and expense report: %s", err))
+	}
+
+	return types.Ok(types.MapIncomeExpenseReport(report))
+}
+
+func (s *Server) GetTrend(input types.TrendInput) types.Result[types.TrendReport] {
+	ctx := context.Background()
+
+	report, err := s.reportService.Trend(ctx, input.AccountId, input.Periods)
+	if err != nil {
+		return types.Fail[types.TrendReport](fmt.Sprintf("trend report: %s", err))
+	}
+
+	return types.Ok(types.MapTrendReport(report))
+}
+
+func (s *Server) GetNetWorth() types.Result[types.NetWorth] {
+	ctx := context.Background()
+
+	worth, err := s.reportService.NetWorth(ctx)
+	if err != nil {
+		return types.Fail[types.NetWorth](fmt.Sprintf("net worth: %s", err))
+	}
+
+	return types.Ok(types.MapNetWorth(worth))
+}
+
+func (s *Server) GetBalanceSeries(accountId int64, periodId int64) types.Result[types.BalanceSeries] {
+	ctx := context.Background()
+
+	series, err := s.reportService.BalanceSeries(ctx, accountId, periodId)
+	if err != nil {
+		return types.Fail[types.BalanceSeries](fmt.Sprintf("balance series: %s", err))
+	}
+
+	return types.Ok(types.MapBalanceSeries(series))
+}
+
+func (s *Server) ListImportProfiles(accountId int64) types.Result[[]types.ImportProfile] {
+	ctx := context.Background()
+
+	list, err := s.importService.ListProfiles(ctx, accountId)
+	if err != nil {
+		return types.Fail[[]types.ImportProfile](fmt.Sprintf("list import profiles: %s", err))
+	}
+
+	return types.Ok(types.MapImportProfiles(list))
+}
+
+func (s *Server) AddImportProfile(accountId int64, input types.ImportProfileInput) types.Result[types.ImportProfile] {
+	ctx := context.Background()
+
+	p, err := s.importService.AddProfile(ctx, accountId, input)
+	if err != nil {
+		return types.Fail[types.ImportProfile](fmt.Sprintf("add import profile: %s", err))
+	}
+
+	return types.Ok(types.MapImportProfile(p))
+}
+
+func (s *Server) UpdateImportProfile(accountId int64, input types.ImportProfileInput) types.Result[types.ImportProfile] {
+	ctx := context.Background()
+
+	p, err := s.importService.UpdateProfile(ctx, accountId, input)
+	if err != nil {
+		return types.Fail[types.ImportProfile](fmt.Sprintf("update import profile: %s", err))
+	}
+
+	return types.Ok(types.MapImportProfile(p))
+}
+
+func (s *Server) DeleteImportProfile(profileId int64) types.SimpleResult {
+	ctx := context.Background()
+
+	err := s.importService.DeleteProfile(ctx, profileId)
+	if err != nil {
+		return types.SimpleResult{Success: false, Message: fmt.Sprintf("error deleting import profile: %s", err)}
+	}
+
+	return types.SimpleResult{Success: true, Message: "Deleted"}
+}
+
+func (s *Server) PreviewCSVImport(input types.CSVImportInput) types.Result[[]types.ImportRow] {
+	ctx := context.Background()
+
+	rows, err := s.importService.PreviewCSV(ctx, input)
+	if err != nil {
+		return types.Fail[[]types.ImportRow](fmt.Sprintf("preview csv import: %s", err))
+	}
+
+	return types.Ok(types.MapImportRows(rows))
+}
+
+func (s *Server) ImportCSV(input types.CSVImportInput) types.Result[[]types.Transaction] {
+	ctx := context.Background()
+
+	transactions, err := s.importService.ImportCSV(ctx, input)
+	if err != nil {
+		return types.Fail[[]types.Transaction](fmt.Sprintf("import csv: %s", err))
+	}
+
+	return types.Ok(types.MapTransactions(transactions))
+}
+
+func (s *Server) PreviewOFXImport(input types.FileImportInput) types.Result[[]types.ImportRow] {
+	ctx := context.Background()
+
+	rows, err := s.importService.PreviewOFX(ctx, input)
+	if err != nil {
+		return types.Fail[[]types.ImportRow](fmt.Sprintf("preview ofx import: %s", err))
+	}
+
+	return types.Ok(types.MapImportRows(rows))
+}
+
+func (s *Server) ImportOFX(input types.FileImportInput) types.Result[[]types.Transaction] {
+	ctx := context.Background()
+
+	transactions, err := s.importService.ImportOFX(ctx, input)
+	if err != nil {
+		return types.Fail[[]types.Transaction](fmt.Sprintf("import ofx: %s", err))
+	}
+
+	return types.Ok(types.MapTransactions(transactions))
+}
+
+func (s *Server) PreviewQIFImport(input types.QIFImportInput) types.Result[[]types.ImportRow] {
+	ctx := context.Background()
+
+	rows, err := s.importService.PreviewQIF(ctx, input)
+	if err != nil {
+		return types.Fail[[]types.ImportRow](fmt.Sprintf("preview qif import: %s", err))
+	}
+
+	return types.Ok(types.MapImportRows(rows))
+}
+
+func (s *Server) ImportQIF(input types.QIFImportInput) types.Result[[]types.Transaction] {
+	ctx := context.Background()
+
+	transactions, err := s.importService.ImportQIF(ctx, input)
+	if err != nil {
+		return types.Fail[[]types.Transaction](fmt.Sprintf("import qif: %s", err))
+	}
+
+	return types.Ok(types.MapTransactions(transactions))
+}
+
+func (s *Server) ExportQIF(input types.PeriodRangeInput) types.Result[types.ExportFile] {
+	ctx := context.Background()
+
+	file, err := s.exportService.ExportQIF(ctx, input)
+	if err != nil {
+		return types.Fail[types.ExportFile](fmt.Sprintf("export qif: %s", err))
+	}
+
+	return types.Ok(types.MapExportFile(file))
+}
+
+func (s *Server) ExportJournal(input types.JournalExportInput) types.Result[types.ExportFile] {
+	ctx := context.Background()
+
+	file, err := s.exportService.ExportJournal(ctx, input)
+	if err != nil {
+		return types.Fail[types.ExportFile](fmt.Sprintf("export journal: %s", err))
+	}
+
+	return types.Ok(types.MapExportFile(file))
+}
+
+// ListStartupMigrations returns the migrations that were pending when the
+// database was opened, listed before Startup applied them. Use
+// PendingMigrations to see them without migrating.
+func (s *Server) ListStartupMigrations() types.Result[[]types.Migration] {
+	return types.Ok(types.MapMigrations(s.startupMigrations))
+}
+
+func (s *Server) ExportLedger() types.Result[types.ExportFile] {
+	ctx := context.Background()
+
+	file, err := s.ledgerService.Export(ctx)
+	if err != nil {
+		return types.Fail[types.ExportFile](fmt.Sprintf("export ledger: %s", err))
+	}
+
+	return types.Ok(types.MapExportFile(file))
+}
+
+func (s *Server) ImportLedger(content string) types.SimpleResult {
+	ctx := context.Background()
+
+	err := s.ledgerService.Import(ctx, content)
+	if err != nil {
+		return types.SimpleResult{Success: false, Message: fmt.Sprintf("error importing ledger: %s", err)}
+	}
+
+	return types.SimpleResult{Success: true, Message: "Imported"}
+}